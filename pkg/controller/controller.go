@@ -105,6 +105,8 @@ const (
 	PVCNotReadyReason = "PVCNotReady"
 	// FailedHotplugSyncReason is set when a hotplug specific failure occurs during sync
 	FailedHotplugSyncReason = "FailedHotplugSync"
+	// EphemeralHotplugVolumeLimitReachedReason is set when ephemeral hotplug volumes are held because the namespace or cluster limit is reached
+	EphemeralHotplugVolumeLimitReachedReason = "EphemeralHotplugVolumeLimitReached"
 	// ErrImagePullReason is set when an error has occured while pulling an image for a containerDisk VM volume.
 	ErrImagePullReason = "ErrImagePull"
 	// ImagePullBackOffReason is set when an error has occured while pulling an image for a containerDisk VM volume,
@@ -0,0 +1,108 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package catalog
+
+import (
+	"encoding/json"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
+
+	"kubevirt.io/client-go/log"
+)
+
+// LabelDoc is a variable label of a metric.
+type LabelDoc struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
+
+// MetricDoc describes a single metric in the catalog.
+type MetricDoc struct {
+	Name           string            `json:"name"`
+	Help           string            `json:"help"`
+	Type           string            `json:"type"`
+	Labels         []LabelDoc        `json:"labels"`
+	ConstLabels    map[string]string `json:"constLabels,omitempty"`
+	StabilityLevel StabilityLevel    `json:"stabilityLevel,omitempty"`
+}
+
+// Handler serves the catalog of all metrics registered through operatormetrics
+// in the current process as JSON. Metrics reported by custom collectors are
+// included, since they are registered together with their collector.
+func Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(Build(operatormetrics.ListMetrics())); err != nil {
+			log.Log.Reason(err).Error("failed to write the metrics catalog")
+		}
+	})
+}
+
+// Build returns the catalog of the given metrics, in the given order.
+func Build(metrics []operatormetrics.Metric) []MetricDoc {
+	docs := make([]MetricDoc, 0, len(metrics))
+	for _, metric := range metrics {
+		opts := metric.GetOpts()
+		docs = append(docs, MetricDoc{
+			Name:           opts.Name,
+			Help:           opts.Help,
+			Type:           strings.ToLower(string(metric.GetBaseType())),
+			Labels:         buildLabels(opts.ExtraFields),
+			ConstLabels:    opts.ConstLabels,
+			StabilityLevel: StabilityLevel(opts.ExtraFields[stabilityLevelField]),
+		})
+	}
+	return docs
+}
+
+// buildLabels returns the labels the metric was created with, see NewGaugeVec and the
+// other constructors, followed by the labels that were only declared through
+// WithLabelDescription. The latter cover collectors that set their labels per result.
+func buildLabels(extraFields map[string]string) []LabelDoc {
+	labels := []LabelDoc{}
+	if value := extraFields[labelsField]; value != "" {
+		for _, name := range strings.Split(value, labelsSeparator) {
+			labels = append(labels, LabelDoc{
+				Name:        name,
+				Description: extraFields[labelDescriptionFieldPrefix+name],
+			})
+		}
+	}
+
+	var declared []string
+	for key := range extraFields {
+		name, found := strings.CutPrefix(key, labelDescriptionFieldPrefix)
+		if found && !slices.ContainsFunc(labels, func(label LabelDoc) bool { return label.Name == name }) {
+			declared = append(declared, name)
+		}
+	}
+	slices.Sort(declared)
+	for _, name := range declared {
+		labels = append(labels, LabelDoc{
+			Name:        name,
+			Description: extraFields[labelDescriptionFieldPrefix+name],
+		})
+	}
+
+	return labels
+}
@@ -0,0 +1,162 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package catalog
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
+)
+
+var _ = Describe("metrics catalog", func() {
+	Context("Opts", func() {
+		It("should keep existing extra fields", func() {
+			opts := Opts(operatormetrics.MetricOpts{
+				Name:        "test_metric",
+				ExtraFields: map[string]string{"DeprecatedVersion": "1.0.0"},
+			}, WithStabilityLevel(Deprecated))
+
+			Expect(opts.ExtraFields).To(Equal(map[string]string{
+				"DeprecatedVersion": "1.0.0",
+				"StabilityLevel":    "DEPRECATED",
+			}))
+		})
+	})
+
+	Context("Build", func() {
+		It("should describe a metric with labels and catalog options", func() {
+			metric := NewGaugeVec(
+				Opts(operatormetrics.MetricOpts{
+					Name:        "test_volume_size_bytes",
+					Help:        "Test volume size.",
+					ConstLabels: map[string]string{"component": "test"},
+				},
+					WithStabilityLevel(Alpha),
+					WithLabelDescription("source", "Source of the volume."),
+				),
+				[]string{"name", "source"},
+			)
+
+			Expect(Build([]operatormetrics.Metric{metric})).To(Equal([]MetricDoc{{
+				Name: "test_volume_size_bytes",
+				Help: "Test volume size.",
+				Type: "gauge",
+				Labels: []LabelDoc{
+					{Name: "name"},
+					{Name: "source", Description: "Source of the volume."},
+				},
+				ConstLabels:    map[string]string{"component": "test"},
+				StabilityLevel: Alpha,
+			}}))
+		})
+
+		It("should include labels declared for collector results", func() {
+			metric := operatormetrics.NewGauge(
+				Opts(operatormetrics.MetricOpts{
+					Name: "test_dirty_rate_bytes_per_second",
+					Help: "Test dirty rate.",
+				},
+					WithLabelDescription("node", "Node of the VMI."),
+					WithLabelDescription("name", "Name of the VMI."),
+				),
+			)
+
+			Expect(Build([]operatormetrics.Metric{metric})[0].Labels).To(Equal([]LabelDoc{
+				{Name: "name", Description: "Name of the VMI."},
+				{Name: "node", Description: "Node of the VMI."},
+			}))
+		})
+
+		It("should describe the labels of histogram and summary vectors", func() {
+			histogram := NewHistogramVec(
+				operatormetrics.MetricOpts{Name: "test_histogram_seconds"},
+				prometheus.HistogramOpts{},
+				[]string{"verb", "resource"},
+			)
+			summary := NewSummaryVec(
+				operatormetrics.MetricOpts{Name: "test_summary_seconds"},
+				prometheus.SummaryOpts{},
+				[]string{"phase"},
+			)
+
+			docs := Build([]operatormetrics.Metric{histogram, summary})
+			Expect(docs[0].Labels).To(Equal([]LabelDoc{{Name: "verb"}, {Name: "resource"}}))
+			Expect(docs[1].Labels).To(Equal([]LabelDoc{{Name: "phase"}}))
+		})
+
+		It("should describe a metric without labels or catalog options", func() {
+			metric := operatormetrics.NewHistogram(
+				operatormetrics.MetricOpts{
+					Name: "test_duration_seconds",
+					Help: "Test duration.",
+				},
+				prometheus.HistogramOpts{},
+			)
+
+			Expect(Build([]operatormetrics.Metric{metric})).To(Equal([]MetricDoc{{
+				Name:   "test_duration_seconds",
+				Help:   "Test duration.",
+				Type:   "histogram",
+				Labels: []LabelDoc{},
+			}}))
+		})
+	})
+
+	Context("Handler", func() {
+		It("should serve metrics reported by collectors", func() {
+			metric := NewCounterVec(
+				operatormetrics.MetricOpts{
+					Name: "test_collected_total",
+					Help: "Test collected counter.",
+				},
+				[]string{"namespace"},
+			)
+			collector := operatormetrics.Collector{
+				Metrics: []operatormetrics.Metric{metric},
+				CollectCallback: func() []operatormetrics.CollectorResult {
+					return nil
+				},
+			}
+			Expect(operatormetrics.RegisterCollector(collector)).To(Succeed())
+			DeferCleanup(operatormetrics.CleanRegistry)
+
+			recorder := httptest.NewRecorder()
+			Handler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/metrics/docs", nil))
+
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			Expect(recorder.Header().Get("Content-Type")).To(Equal("application/json"))
+
+			var entries []MetricDoc
+			Expect(json.Unmarshal(recorder.Body.Bytes(), &entries)).To(Succeed())
+			Expect(entries).To(ContainElement(MetricDoc{
+				Name:   "test_collected_total",
+				Help:   "Test collected counter.",
+				Type:   "counter",
+				Labels: []LabelDoc{{Name: "namespace"}},
+			}))
+		})
+	})
+})
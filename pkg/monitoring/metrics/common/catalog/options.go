@@ -0,0 +1,103 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package catalog
+
+import (
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
+)
+
+// StabilityLevel describes how likely a metric is to change in a future release.
+type StabilityLevel string
+
+const (
+	Alpha      StabilityLevel = "ALPHA"
+	Beta       StabilityLevel = "BETA"
+	Stable     StabilityLevel = "STABLE"
+	Deprecated StabilityLevel = "DEPRECATED"
+)
+
+const (
+	// stabilityLevelField is the ExtraFields key the toolkit docs template already renders.
+	stabilityLevelField         = "StabilityLevel"
+	labelsField                 = "Labels"
+	labelDescriptionFieldPrefix = "LabelDescription/"
+
+	labelsSeparator = ","
+)
+
+// Option declares catalog metadata of a metric when it is defined.
+type Option func(extraFields map[string]string)
+
+// WithStabilityLevel declares the stability level of the metric.
+func WithStabilityLevel(level StabilityLevel) Option {
+	return func(extraFields map[string]string) {
+		extraFields[stabilityLevelField] = string(level)
+	}
+}
+
+// WithLabelDescription declares what the values of the given label mean.
+func WithLabelDescription(label, description string) Option {
+	return func(extraFields map[string]string) {
+		extraFields[labelDescriptionFieldPrefix+label] = description
+	}
+}
+
+// Opts returns opts with the catalog metadata of the given options stored in its ExtraFields.
+func Opts(opts operatormetrics.MetricOpts, options ...Option) operatormetrics.MetricOpts {
+	extraFields := make(map[string]string, len(opts.ExtraFields)+len(options))
+	for key, value := range opts.ExtraFields {
+		extraFields[key] = value
+	}
+	for _, option := range options {
+		option(extraFields)
+	}
+	opts.ExtraFields = extraFields
+	return opts
+}
+
+// NewCounterVec creates an operatormetrics.CounterVec and records its labels for the catalog.
+func NewCounterVec(opts operatormetrics.MetricOpts, labels []string) *operatormetrics.CounterVec {
+	return operatormetrics.NewCounterVec(withLabels(opts, labels), labels)
+}
+
+// NewGaugeVec creates an operatormetrics.GaugeVec and records its labels for the catalog.
+func NewGaugeVec(opts operatormetrics.MetricOpts, labels []string) *operatormetrics.GaugeVec {
+	return operatormetrics.NewGaugeVec(withLabels(opts, labels), labels)
+}
+
+// NewHistogramVec creates an operatormetrics.HistogramVec and records its labels for the catalog.
+func NewHistogramVec(opts operatormetrics.MetricOpts, histogramOpts prometheus.HistogramOpts, labels []string) *operatormetrics.HistogramVec {
+	return operatormetrics.NewHistogramVec(withLabels(opts, labels), histogramOpts, labels)
+}
+
+// NewSummaryVec creates an operatormetrics.SummaryVec and records its labels for the catalog.
+func NewSummaryVec(opts operatormetrics.MetricOpts, summaryOpts prometheus.SummaryOpts, labels []string) *operatormetrics.SummaryVec {
+	return operatormetrics.NewSummaryVec(withLabels(opts, labels), summaryOpts, labels)
+}
+
+// withLabels stores the label names in the ExtraFields, since operatormetrics does not expose them.
+func withLabels(opts operatormetrics.MetricOpts, labels []string) operatormetrics.MetricOpts {
+	return Opts(opts, func(extraFields map[string]string) {
+		extraFields[labelsField] = strings.Join(labels, labelsSeparator)
+	})
+}
@@ -0,0 +1,106 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package catalog_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
+
+	"kubevirt.io/kubevirt/pkg/monitoring/metrics/common/catalog"
+	"kubevirt.io/kubevirt/pkg/monitoring/metrics/common/workqueue"
+	virtapi "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-api"
+	virtcontroller "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-controller"
+	virthandler "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-handler"
+	virtoperator "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-operator"
+)
+
+var _ = Describe("registered metrics", func() {
+	vectorTypes := map[operatormetrics.MetricType]struct{}{
+		operatormetrics.CounterVecType:   {},
+		operatormetrics.GaugeVecType:     {},
+		operatormetrics.HistogramVecType: {},
+		operatormetrics.SummaryVecType:   {},
+	}
+
+	registerAllMetrics := func() {
+		Expect(virtcontroller.SetupMetrics(nil, nil, nil, nil)).To(Succeed())
+		Expect(virtcontroller.RegisterLeaderMetrics()).To(Succeed())
+		Expect(virtapi.SetupMetrics()).To(Succeed())
+		Expect(virtoperator.SetupMetrics()).To(Succeed())
+		Expect(virtoperator.RegisterLeaderMetrics()).To(Succeed())
+		Expect(virthandler.SetupMetrics("", 0, nil, nil, nil)).To(Succeed())
+
+		workqueueMetricsProvider := workqueue.NewPrometheusMetricsProvider()
+		workqueueMetricsProvider.NewAddsMetric("")
+		workqueueMetricsProvider.NewDepthMetric("")
+		workqueueMetricsProvider.NewLatencyMetric("")
+		workqueueMetricsProvider.NewWorkDurationMetric("")
+		workqueueMetricsProvider.NewUnfinishedWorkSecondsMetric("")
+		workqueueMetricsProvider.NewLongestRunningProcessorSecondsMetric("")
+		workqueueMetricsProvider.NewRetriesMetric("")
+	}
+
+	It("should describe the labels of every vector metric", func() {
+		registerAllMetrics()
+		DeferCleanup(operatormetrics.CleanRegistry)
+
+		metrics := operatormetrics.ListMetrics()
+		docs := catalog.Build(metrics)
+		Expect(docs).To(HaveLen(len(metrics)))
+
+		var vectorsWithoutLabels []string
+		for i, metric := range metrics {
+			if _, vector := vectorTypes[metric.GetType()]; vector && len(docs[i].Labels) == 0 {
+				vectorsWithoutLabels = append(vectorsWithoutLabels, docs[i].Name)
+			}
+		}
+		Expect(vectorsWithoutLabels).To(BeEmpty(), "vector metrics must be created through the catalog constructors")
+	})
+
+	// Collector metrics that are reported without any label
+	collectorMetricsWithoutLabels := map[string]struct{}{
+		"kubevirt_controller_metrics_ready":           {},
+		"kubevirt_vmi_migrations_in_pending_phase":    {},
+		"kubevirt_vmi_migrations_in_running_phase":    {},
+		"kubevirt_vmi_migrations_in_scheduling_phase": {},
+		"kubevirt_vmi_migrations_in_unset_phase":      {},
+	}
+
+	It("should describe the labels of every collector metric", func() {
+		registerAllMetrics()
+		DeferCleanup(operatormetrics.CleanRegistry)
+
+		// Only the metrics of the registered collectors are left listed
+		Expect(operatormetrics.UnregisterMetrics(operatormetrics.ListMetrics())).To(Succeed())
+
+		metrics := operatormetrics.ListMetrics()
+		Expect(metrics).ToNot(BeEmpty())
+
+		var metricsWithoutLabels []string
+		for _, doc := range catalog.Build(metrics) {
+			if _, withoutLabels := collectorMetricsWithoutLabels[doc.Name]; !withoutLabels && len(doc.Labels) == 0 {
+				metricsWithoutLabels = append(metricsWithoutLabels, doc.Name)
+			}
+		}
+		Expect(metricsWithoutLabels).To(BeEmpty(), "collector metrics must declare the labels of their results")
+	})
+})
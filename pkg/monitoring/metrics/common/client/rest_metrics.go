@@ -21,6 +21,8 @@ package client
 import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
+
+	"kubevirt.io/kubevirt/pkg/monitoring/metrics/common/catalog"
 )
 
 const (
@@ -38,7 +40,7 @@ var (
 
 	// requestLatency is a Prometheus Summary metric type partitioned by
 	// "verb" and "url" labels. It is used for the rest client latency metrics.
-	requestLatency = operatormetrics.NewHistogramVec(
+	requestLatency = catalog.NewHistogramVec(
 		operatormetrics.MetricOpts{
 			Name: "kubevirt_rest_client_request_latency_seconds",
 			Help: "Request latency in seconds. Broken down by verb and URL.",
@@ -54,7 +56,7 @@ var (
 		[]string{"verb", "url"},
 	)
 
-	rateLimiterLatency = operatormetrics.NewHistogramVec(
+	rateLimiterLatency = catalog.NewHistogramVec(
 		operatormetrics.MetricOpts{
 			Name: "kubevirt_rest_client_rate_limiter_duration_seconds",
 			Help: "Client side rate limiter latency in seconds. Broken down by verb and URL.",
@@ -65,7 +67,7 @@ var (
 		[]string{"verb", "url"},
 	)
 
-	requestResult = operatormetrics.NewCounterVec(
+	requestResult = catalog.NewCounterVec(
 		operatormetrics.MetricOpts{
 			Name: "kubevirt_rest_client_requests_total",
 			Help: "Number of HTTP requests, partitioned by status code, method, and host.",
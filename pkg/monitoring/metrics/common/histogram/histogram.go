@@ -0,0 +1,59 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ */
+
+package histogram
+
+import (
+	"slices"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DurationBuckets converts the durations configured in the KubeVirt CR to sorted, unique bucket
+// upper bounds in seconds. Non-positive durations are dropped. It returns nil if no bucket is left.
+func DurationBuckets(durations []metav1.Duration) []float64 {
+	var buckets []float64
+	for _, d := range durations {
+		if d.Duration > 0 {
+			buckets = append(buckets, d.Seconds())
+		}
+	}
+	slices.Sort(buckets)
+	return slices.Compact(buckets)
+}
+
+// SetBuckets recreates the histogram with the given buckets. The metric description does not
+// change, but observations made before are lost, so it must be called before the histogram is used.
+func SetBuckets(h *operatormetrics.Histogram, buckets []float64) {
+	h.Histogram = prometheus.NewHistogram(histogramOpts(h.GetOpts(), h.GetHistogramOpts(), buckets))
+}
+
+// SetVecBuckets recreates the histogram vector with the given buckets, see SetBuckets.
+func SetVecBuckets(vec *operatormetrics.HistogramVec, labels []string, buckets []float64) {
+	vec.HistogramVec = *prometheus.NewHistogramVec(histogramOpts(vec.GetOpts(), vec.GetHistogramOpts(), buckets), labels)
+}
+
+func histogramOpts(metricOpts operatormetrics.MetricOpts, histogramOpts prometheus.HistogramOpts, buckets []float64) prometheus.HistogramOpts {
+	histogramOpts.Name = metricOpts.Name
+	histogramOpts.Help = metricOpts.Help
+	histogramOpts.ConstLabels = metricOpts.ConstLabels
+	histogramOpts.Buckets = buckets
+	return histogramOpts
+}
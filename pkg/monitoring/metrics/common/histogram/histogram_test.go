@@ -0,0 +1,88 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ */
+
+package histogram_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/prometheus/client_golang/prometheus"
+	ioprometheusclient "github.com/prometheus/client_model/go"
+	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"kubevirt.io/kubevirt/pkg/monitoring/metrics/common/histogram"
+)
+
+var _ = Describe("Histogram buckets", func() {
+	DescribeTable("should convert the configured durations to buckets", func(durations []metav1.Duration, expected []float64) {
+		Expect(histogram.DurationBuckets(durations)).To(Equal(expected))
+	},
+		Entry("with no durations", nil, nil),
+		Entry("with sorted durations",
+			[]metav1.Duration{{Duration: time.Minute}, {Duration: time.Hour}},
+			[]float64{60, 3600},
+		),
+		Entry("with unsorted and duplicated durations",
+			[]metav1.Duration{{Duration: time.Hour}, {Duration: 30 * time.Second}, {Duration: time.Hour}},
+			[]float64{30, 3600},
+		),
+		Entry("with non-positive durations",
+			[]metav1.Duration{{Duration: 0}, {Duration: -time.Second}},
+			nil,
+		),
+	)
+
+	buckets := []float64{60, 600, 3600, 4 * 3600}
+
+	It("should recreate a histogram with the given buckets", func() {
+		h := operatormetrics.NewHistogram(
+			operatormetrics.MetricOpts{Name: "test_duration_seconds", Help: "Test duration."},
+			prometheus.HistogramOpts{Buckets: prometheus.DefBuckets},
+		)
+
+		histogram.SetBuckets(h, buckets)
+
+		h.Observe(2 * 3600)
+		metric := &ioprometheusclient.Metric{}
+		Expect(h.Write(metric)).To(Succeed())
+		Expect(metric.GetHistogram().GetBucket()).To(HaveLen(len(buckets)))
+		Expect(metric.GetHistogram().GetBucket()[3].GetUpperBound()).To(Equal(4 * 3600.0))
+		Expect(metric.GetHistogram().GetBucket()[3].GetCumulativeCount()).To(Equal(uint64(1)))
+		Expect(h.GetOpts().Name).To(Equal("test_duration_seconds"))
+	})
+
+	It("should recreate a histogram vector with the given buckets", func() {
+		vec := operatormetrics.NewHistogramVec(
+			operatormetrics.MetricOpts{Name: "test_phase_duration_seconds", Help: "Test phase duration."},
+			prometheus.HistogramOpts{Buckets: prometheus.DefBuckets},
+			[]string{"phase"},
+		)
+
+		histogram.SetVecBuckets(vec, []string{"phase"}, buckets)
+
+		vec.WithLabelValues("Running").Observe(90)
+		metric := &ioprometheusclient.Metric{}
+		Expect(vec.WithLabelValues("Running").(prometheus.Histogram).Write(metric)).To(Succeed())
+		Expect(metric.GetHistogram().GetBucket()).To(HaveLen(len(buckets)))
+		Expect(metric.GetHistogram().GetBucket()[1].GetCumulativeCount()).To(Equal(uint64(1)))
+	})
+})
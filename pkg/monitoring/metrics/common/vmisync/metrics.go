@@ -22,6 +22,8 @@ import (
 	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
 	"k8s.io/client-go/tools/cache"
 	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/monitoring/metrics/common/catalog"
 )
 
 var (
@@ -29,7 +31,7 @@ var (
 		vmiSyncTotal,
 	}
 
-	vmiSyncTotal = operatormetrics.NewCounterVec(
+	vmiSyncTotal = catalog.NewCounterVec(
 		operatormetrics.MetricOpts{
 			Name: "kubevirt_vmi_sync_total",
 			Help: "Total number of times a VirtualMachineInstance has been synced.",
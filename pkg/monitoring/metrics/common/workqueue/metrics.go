@@ -22,6 +22,8 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
 	k8sworkqueue "k8s.io/client-go/util/workqueue"
+
+	"kubevirt.io/kubevirt/pkg/monitoring/metrics/common/catalog"
 )
 
 const (
@@ -41,7 +43,7 @@ var (
 		unfinishedWork,
 	}
 
-	depth = operatormetrics.NewGaugeVec(
+	depth = catalog.NewGaugeVec(
 		operatormetrics.MetricOpts{
 			Name: "kubevirt_workqueue_depth",
 			Help: "Current depth of workqueue",
@@ -49,7 +51,7 @@ var (
 		[]string{"name"},
 	)
 
-	adds = operatormetrics.NewCounterVec(
+	adds = catalog.NewCounterVec(
 		operatormetrics.MetricOpts{
 			Name: "kubevirt_workqueue_adds_total",
 			Help: "Total number of adds handled by workqueue",
@@ -57,7 +59,7 @@ var (
 		[]string{"name"},
 	)
 
-	latency = operatormetrics.NewHistogramVec(
+	latency = catalog.NewHistogramVec(
 		operatormetrics.MetricOpts{
 			Name: "kubevirt_workqueue_queue_duration_seconds",
 			Help: "How long an item stays in workqueue before being requested.",
@@ -68,7 +70,7 @@ var (
 		[]string{"name"},
 	)
 
-	workDuration = operatormetrics.NewHistogramVec(
+	workDuration = catalog.NewHistogramVec(
 		operatormetrics.MetricOpts{
 			Name: "kubevirt_workqueue_work_duration_seconds",
 			Help: "How long in seconds processing an item from workqueue takes.",
@@ -79,7 +81,7 @@ var (
 		[]string{"name"},
 	)
 
-	retries = operatormetrics.NewCounterVec(
+	retries = catalog.NewCounterVec(
 		operatormetrics.MetricOpts{
 			Name: "kubevirt_workqueue_retries_total",
 			Help: "Total number of retries handled by workqueue",
@@ -87,7 +89,7 @@ var (
 		[]string{"name"},
 	)
 
-	longestRunningProcessor = operatormetrics.NewGaugeVec(
+	longestRunningProcessor = catalog.NewGaugeVec(
 		operatormetrics.MetricOpts{
 			Name: "kubevirt_workqueue_longest_running_processor_seconds",
 			Help: "How many seconds has the longest running processor for workqueue been running.",
@@ -95,7 +97,7 @@ var (
 		[]string{"name"},
 	)
 
-	unfinishedWork = operatormetrics.NewGaugeVec(
+	unfinishedWork = catalog.NewGaugeVec(
 		operatormetrics.MetricOpts{
 			Name: "kubevirt_workqueue_unfinished_work_seconds",
 			Help: "How many seconds of work have been in progress without " +
@@ -22,6 +22,8 @@ import (
 	"time"
 
 	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
+
+	"kubevirt.io/kubevirt/pkg/monitoring/metrics/common/catalog"
 )
 
 var (
@@ -35,7 +37,7 @@ var (
 
 	namespaceAndVMILabels = []string{"namespace", "vmi"}
 
-	activePortForwardTunnels = operatormetrics.NewGaugeVec(
+	activePortForwardTunnels = catalog.NewGaugeVec(
 		operatormetrics.MetricOpts{
 			Name: "kubevirt_portforward_active_tunnels",
 			Help: "Amount of active portforward tunnels, broken down by namespace and vmi name.",
@@ -43,7 +45,7 @@ var (
 		namespaceAndVMILabels,
 	)
 
-	activeVNCConnections = operatormetrics.NewGaugeVec(
+	activeVNCConnections = catalog.NewGaugeVec(
 		operatormetrics.MetricOpts{
 			Name: "kubevirt_vnc_active_connections",
 			Help: "Amount of active VNC connections, broken down by namespace and vmi name.",
@@ -51,7 +53,7 @@ var (
 		namespaceAndVMILabels,
 	)
 
-	activeConsoleConnections = operatormetrics.NewGaugeVec(
+	activeConsoleConnections = catalog.NewGaugeVec(
 		operatormetrics.MetricOpts{
 			Name: "kubevirt_console_active_connections",
 			Help: "Amount of active Console connections, broken down by namespace and vmi name.",
@@ -59,7 +61,7 @@ var (
 		namespaceAndVMILabels,
 	)
 
-	activeUSBRedirConnections = operatormetrics.NewGaugeVec(
+	activeUSBRedirConnections = catalog.NewGaugeVec(
 		operatormetrics.MetricOpts{
 			Name: "kubevirt_usbredir_active_connections",
 			Help: "Amount of active USB redirection connections, broken down by namespace and vmi name.",
@@ -67,7 +69,7 @@ var (
 		namespaceAndVMILabels,
 	)
 
-	vmiLastConnectionTimestamp = operatormetrics.NewGaugeVec(
+	vmiLastConnectionTimestamp = catalog.NewGaugeVec(
 		operatormetrics.MetricOpts{
 			Name: "kubevirt_vmi_last_api_connection_timestamp_seconds",
 			Help: "Virtual Machine Instance last API connection timestamp. Including VNC, console, portforward, SSH and usbredir connections.",
@@ -0,0 +1,43 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ */
+
+package virtapi
+
+import (
+	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
+
+	"kubevirt.io/kubevirt/pkg/monitoring/metrics/common/catalog"
+)
+
+var (
+	evictionMetrics = []operatormetrics.Metric{
+		vmiEvictionBlocked,
+	}
+
+	vmiEvictionBlocked = catalog.NewCounterVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_eviction_blocked_total",
+			Help: "Total number of virt-launcher and hotplug pod eviction requests denied without triggering an evacuation, by reason.",
+		},
+		[]string{"reason"},
+	)
+)
+
+func VMIEvictionBlocked(reason string) {
+	vmiEvictionBlocked.WithLabelValues(reason).Inc()
+}
@@ -38,6 +38,8 @@ func SetupMetrics() error {
 	return operatormetrics.RegisterMetrics(
 		componentMetrics,
 		connectionMetrics,
+		evictionMetrics,
+		requestMetrics,
 		vmMetrics,
 	)
 }
@@ -0,0 +1,81 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ */
+
+package virtapi
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"kubevirt.io/kubevirt/pkg/monitoring/metrics/common/catalog"
+	"kubevirt.io/kubevirt/pkg/monitoring/metrics/common/histogram"
+)
+
+var (
+	requestMetrics = []operatormetrics.Metric{
+		requestDuration,
+		requestsInFlight,
+	}
+
+	requestDurationLabels = []string{"resource", "verb", "code"}
+
+	requestDuration = catalog.NewHistogramVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_api_request_duration_seconds",
+			Help: "Histogram of the time virt-api takes to serve a request, broken down by resource, verb and " +
+				"status code. Streaming subresources like console and vnc are observed when the connection is closed.",
+		},
+		prometheus.HistogramOpts{
+			Buckets: prometheus.DefBuckets,
+		},
+		requestDurationLabels,
+	)
+
+	requestsInFlight = catalog.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_api_requests_in_flight",
+			Help: "Amount of requests currently being served by virt-api, broken down by resource and verb.",
+		},
+		[]string{"resource", "verb"},
+	)
+)
+
+// NewRequestInFlight increments the metric for in-flight requests by one for resource and verb
+// and returns a recorder for decrementing it once the request is served
+func NewRequestInFlight(resource, verb string) Decrementer {
+	recorder := requestsInFlight.WithLabelValues(resource, verb)
+	recorder.Inc()
+	return recorder
+}
+
+// SetDurationHistogramBuckets recreates the request duration histogram with the buckets configured
+// in the KubeVirt CR. virt-api registers its metrics before it reads the cluster configuration, so
+// it must be called before virt-api starts serving requests.
+func SetDurationHistogramBuckets(durations []metav1.Duration) {
+	if buckets := histogram.DurationBuckets(durations); len(buckets) > 0 {
+		histogram.SetVecBuckets(requestDuration, requestDurationLabels, buckets)
+	}
+}
+
+func ObserveRequestDuration(resource, verb string, code int, duration time.Duration) {
+	requestDuration.WithLabelValues(resource, verb, strconv.Itoa(code)).Observe(duration.Seconds())
+}
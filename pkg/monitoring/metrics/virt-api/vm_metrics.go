@@ -22,22 +22,38 @@ package virtapi
 import (
 	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
 	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/monitoring/metrics/common/catalog"
 )
 
 var (
 	vmMetrics = []operatormetrics.Metric{
 		vmsCreatedCounter,
+		vmOperations,
 	}
 
-	vmsCreatedCounter = operatormetrics.NewCounterVec(
+	vmsCreatedCounter = catalog.NewCounterVec(
 		operatormetrics.MetricOpts{
 			Name: "kubevirt_vm_created_by_pod_total",
 			Help: "[Deprecated] The total number of VMs created by namespace and virt-api pod, since install.",
 		},
 		[]string{"namespace"},
 	)
+
+	vmOperations = catalog.NewCounterVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vm_operations_total",
+			Help: "The total number of lifecycle operations accepted by the virt-api subresources, " +
+				"by operation and kind of initiator (user, serviceaccount or system).",
+		},
+		[]string{"operation", "initiator"},
+	)
 )
 
 func NewVMCreated(vm *v1.VirtualMachine) {
 	vmsCreatedCounter.WithLabelValues(vm.Namespace).Inc()
 }
+
+func NewVMOperation(operation, initiator string) {
+	vmOperations.WithLabelValues(operation, initiator).Inc()
+}
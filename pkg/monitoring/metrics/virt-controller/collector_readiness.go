@@ -0,0 +1,106 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtcontroller
+
+import (
+	"sync"
+
+	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
+	"k8s.io/client-go/tools/cache"
+)
+
+var (
+	metricsReadinessCollector = operatormetrics.Collector{
+		Metrics: []operatormetrics.Metric{
+			controllerMetricsReady,
+		},
+		CollectCallback: metricsReadinessCollectorCallback,
+	}
+
+	controllerMetricsReady = operatormetrics.NewGauge(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_controller_metrics_ready",
+			Help: "Indication for a virt-controller whose informer caches are synced and whose collectors report complete data.",
+		},
+	)
+
+	collectorsReadiness = &cacheSyncGate{}
+)
+
+// cacheSyncGate reports whether the informer caches the collectors read from have synced.
+// Once all the caches have synced the gate stays open, as informers do not lose their
+// synced state.
+type cacheSyncGate struct {
+	mu             sync.Mutex
+	synced         bool
+	informerSynced []cache.InformerSynced
+}
+
+func (g *cacheSyncGate) setInformersSynced(informerSynced ...cache.InformerSynced) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.synced = false
+	g.informerSynced = informerSynced
+}
+
+func (g *cacheSyncGate) hasSynced() bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.synced {
+		return true
+	}
+
+	for _, informerSynced := range g.informerSynced {
+		if !informerSynced() {
+			return false
+		}
+	}
+
+	g.synced = true
+	return true
+}
+
+// SetCollectorsInformersSynced registers the sync status of the informers backing the
+// metric collectors. Collectors report nothing until all of them have synced, so partial
+// data is not exported while virt-controller is starting up.
+func SetCollectorsInformersSynced(informerSynced ...cache.InformerSynced) {
+	collectorsReadiness.setInformersSynced(informerSynced...)
+}
+
+func whenCachesSynced(callback func() []operatormetrics.CollectorResult) func() []operatormetrics.CollectorResult {
+	return func() []operatormetrics.CollectorResult {
+		if !collectorsReadiness.hasSynced() {
+			return nil
+		}
+
+		return callback()
+	}
+}
+
+func metricsReadinessCollectorCallback() []operatormetrics.CollectorResult {
+	var ready float64
+	if collectorsReadiness.hasSynced() {
+		ready = 1
+	}
+
+	return []operatormetrics.CollectorResult{{Metric: controllerMetricsReady, Value: ready}}
+}
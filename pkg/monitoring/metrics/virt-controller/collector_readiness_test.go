@@ -0,0 +1,71 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtcontroller
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
+)
+
+var _ = Describe("Collector readiness", func() {
+	var synced bool
+
+	collectorCallback := func() []operatormetrics.CollectorResult {
+		return []operatormetrics.CollectorResult{{Metric: pendingMigrations, Value: 1}}
+	}
+
+	BeforeEach(func() {
+		synced = false
+		SetCollectorsInformersSynced(func() bool { return synced })
+	})
+
+	AfterEach(func() {
+		SetCollectorsInformersSynced()
+	})
+
+	It("should suppress collector output until the informer caches have synced", func() {
+		callback := whenCachesSynced(collectorCallback)
+		Expect(callback()).To(BeEmpty())
+
+		synced = true
+		Expect(callback()).To(HaveLen(1))
+	})
+
+	It("should report kubevirt_controller_metrics_ready according to the informer caches", func() {
+		cr := metricsReadinessCollectorCallback()
+		Expect(cr).To(HaveLen(1))
+		Expect(cr[0].Metric.GetOpts().Name).To(Equal("kubevirt_controller_metrics_ready"))
+		Expect(cr[0].Value).To(BeZero())
+
+		synced = true
+		cr = metricsReadinessCollectorCallback()
+		Expect(cr[0].Value).To(Equal(1.0))
+	})
+
+	It("should stay ready once the informer caches have synced", func() {
+		synced = true
+		Expect(collectorsReadiness.hasSynced()).To(BeTrue())
+
+		synced = false
+		Expect(collectorsReadiness.hasSynced()).To(BeTrue())
+	})
+})
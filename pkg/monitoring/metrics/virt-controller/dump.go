@@ -0,0 +1,62 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ */
+
+package virtcontroller
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/common/expfmt"
+)
+
+// DumpMetricsToFile writes the current values of all registered metrics to path in the
+// Prometheus text format. It is meant for support bundles in environments where the
+// metrics endpoint cannot be scraped. The file is written to a staging file first and
+// renamed, so readers never observe a partial dump.
+func DumpMetricsToFile(path string) (err error) {
+	metricFamilies, err := prometheus.DefaultGatherer.Gather()
+	if err != nil {
+		return fmt.Errorf("failed to gather metrics: %w", err)
+	}
+
+	staging := fmt.Sprintf("%s.staging", path)
+	f, err := os.Create(staging)
+	if err != nil {
+		return fmt.Errorf("failed to create metrics dump '%s': %w", staging, err)
+	}
+	defer func() {
+		if err != nil {
+			_ = os.Remove(staging)
+		}
+	}()
+
+	for _, metricFamily := range metricFamilies {
+		if _, err = expfmt.MetricFamilyToText(f, metricFamily); err != nil {
+			_ = f.Close()
+			return fmt.Errorf("failed to encode metric family %s: %w", metricFamily.GetName(), err)
+		}
+	}
+
+	if err = f.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(staging, path)
+}
@@ -0,0 +1,74 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ */
+
+package virtcontroller
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	ioprometheusclient "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+)
+
+var _ = Describe("DumpMetricsToFile", func() {
+	var dumpPath string
+
+	BeforeEach(func() {
+		dumpPath = filepath.Join(GinkgoT().TempDir(), "metrics.txt")
+
+		Expect(RegisterLeaderMetrics()).To(Succeed())
+		SetOutdatedVirtualMachineInstanceWorkloads(3)
+	})
+
+	It("should write the registered metrics in Prometheus text format", func() {
+		Expect(DumpMetricsToFile(dumpPath)).To(Succeed())
+
+		f, err := os.Open(dumpPath)
+		Expect(err).ToNot(HaveOccurred())
+		defer f.Close()
+
+		parser := expfmt.TextParser{}
+		metricFamilies, err := parser.TextToMetricFamilies(f)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(metricFamilies).To(HaveKey("kubevirt_vmi_number_of_outdated"))
+		outdated := metricFamilies["kubevirt_vmi_number_of_outdated"]
+		Expect(outdated.GetType()).To(Equal(ioprometheusclient.MetricType_GAUGE))
+		Expect(outdated.GetMetric()).To(HaveLen(1))
+		Expect(outdated.GetMetric()[0].GetGauge().GetValue()).To(Equal(3.0))
+	})
+
+	It("should replace an existing dump and not leave the staging file behind", func() {
+		Expect(os.WriteFile(dumpPath, []byte("stale"), 0644)).To(Succeed())
+
+		Expect(DumpMetricsToFile(dumpPath)).To(Succeed())
+
+		content, err := os.ReadFile(dumpPath)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(content)).To(ContainSubstring("kubevirt_vmi_number_of_outdated 3"))
+		Expect(dumpPath + ".staging").ToNot(BeAnExistingFile())
+	})
+
+	It("should fail when the target directory does not exist", func() {
+		Expect(DumpMetricsToFile(filepath.Join(dumpPath, "missing", "metrics.txt"))).ToNot(Succeed())
+	})
+})
@@ -0,0 +1,60 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ */
+
+package virtcontroller
+
+import (
+	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
+
+	"kubevirt.io/kubevirt/pkg/monitoring/metrics/common/histogram"
+)
+
+type durationHistogramVec struct {
+	vec    *operatormetrics.HistogramVec
+	labels []string
+}
+
+var (
+	// durationHistograms and durationHistogramVecs list the histograms created with
+	// PhaseTransitionTimeBuckets, whose buckets can be overridden in the KubeVirt CR.
+	durationHistograms = []*operatormetrics.Histogram{
+		vmiHotplugVolumeAttachDuration,
+		vmiHotplugVolumeDetachDuration,
+		vmiCPUHotplugDuration,
+		vmiMemoryHotplugDuration,
+	}
+
+	durationHistogramVecs = []durationHistogramVec{
+		{vec: vmiPhaseTransition, labels: []string{"phase", "last_phase"}},
+		{vec: vmiPhaseTransitionTimeFromCreation, labels: []string{"phase"}},
+		{vec: vmiPhaseTransitionFromDeletion, labels: []string{"phase"}},
+		{vec: vmiMigrationPhaseTransitionTimeFromCreation, labels: []string{"phase"}},
+		{vec: vmiInterfaceHotplugDuration, labels: []string{"operation"}},
+	}
+)
+
+// setDurationHistogramBuckets recreates the duration histograms with the given buckets.
+// It must be called before the metrics are registered.
+func setDurationHistogramBuckets(buckets []float64) {
+	for _, h := range durationHistograms {
+		histogram.SetBuckets(h, buckets)
+	}
+	for _, h := range durationHistogramVecs {
+		histogram.SetVecBuckets(h.vec, h.labels, buckets)
+	}
+}
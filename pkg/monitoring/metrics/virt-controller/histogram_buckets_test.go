@@ -0,0 +1,49 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ */
+
+package virtcontroller
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/prometheus/client_golang/prometheus"
+	ioprometheusclient "github.com/prometheus/client_model/go"
+)
+
+var _ = Describe("Duration histogram buckets", func() {
+	It("should recreate the duration histograms with the given buckets", func() {
+		DeferCleanup(setDurationHistogramBuckets, PhaseTransitionTimeBuckets())
+		buckets := []float64{60, 600, 3600, 4 * 3600}
+
+		setDurationHistogramBuckets(buckets)
+
+		vmiCPUHotplugDuration.Observe(2 * 3600)
+		metric := &ioprometheusclient.Metric{}
+		Expect(vmiCPUHotplugDuration.Write(metric)).To(Succeed())
+		Expect(metric.GetHistogram().GetBucket()).To(HaveLen(len(buckets)))
+		Expect(metric.GetHistogram().GetBucket()[3].GetUpperBound()).To(Equal(4 * 3600.0))
+		Expect(metric.GetHistogram().GetBucket()[3].GetCumulativeCount()).To(Equal(uint64(1)))
+
+		vmiPhaseTransition.WithLabelValues("Running", "Scheduled").Observe(90)
+		metric = &ioprometheusclient.Metric{}
+		Expect(vmiPhaseTransition.WithLabelValues("Running", "Scheduled").(prometheus.Histogram).Write(metric)).To(Succeed())
+		Expect(metric.GetHistogram().GetBucket()).To(HaveLen(len(buckets)))
+		Expect(metric.GetHistogram().GetBucket()[1].GetCumulativeCount()).To(Equal(uint64(1)))
+	})
+})
@@ -34,6 +34,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/instancetype/find"
 	preferencefind "kubevirt.io/kubevirt/pkg/instancetype/preference/find"
 	"kubevirt.io/kubevirt/pkg/monitoring/metrics/common/client"
+	"kubevirt.io/kubevirt/pkg/monitoring/metrics/common/histogram"
 	"kubevirt.io/kubevirt/pkg/monitoring/metrics/common/vmisync"
 	"kubevirt.io/kubevirt/pkg/monitoring/metrics/common/workqueue"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
@@ -69,6 +70,11 @@ var (
 		migrationMetrics,
 		perfscaleMetrics,
 		vmSnapshotMetrics,
+		vmiHotplugMetrics,
+		vmiResourceHotplugMetrics,
+		vmiCreationMetrics,
+		vmiProbeMetrics,
+		vmiOOMMetrics,
 	}
 
 	indexers       *Indexers
@@ -123,12 +129,21 @@ func SetupMetrics(
 		return err
 	}
 
+	if clusterConfig != nil {
+		if buckets := histogram.DurationBuckets(clusterConfig.GetDurationHistogramBuckets()); len(buckets) > 0 {
+			setDurationHistogramBuckets(buckets)
+		}
+	}
+
 	if err := operatormetrics.RegisterMetrics(metrics...); err != nil {
 		return err
 	}
 
 	return operatormetrics.RegisterCollector(
+		metricsReadinessCollector,
 		migrationStatsCollector,
+		namespaceStatsCollector,
+		vmiUsageCollector,
 		vmiStatsCollector,
 		vmStatsCollector,
 	)
@@ -177,6 +192,21 @@ func PhaseTransitionTimeBuckets() []float64 {
 	}
 }
 
+func LauncherMemoryOverheadBuckets() []float64 {
+	return []float64{
+		32 * mebibyte,
+		64 * mebibyte,
+		128 * mebibyte,
+		192 * mebibyte,
+		256 * mebibyte,
+		384 * mebibyte,
+		512 * mebibyte,
+		768 * mebibyte,
+		1024 * mebibyte,
+		2048 * mebibyte,
+	}
+}
+
 func getTransitionTimeSeconds(oldTime, newTime *metav1.Time) (float64, error) {
 	if newTime == nil || oldTime == nil {
 		// no phase transition timestamp found
@@ -26,6 +26,8 @@ import (
 
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/monitoring/metrics/common/catalog"
 )
 
 const (
@@ -38,7 +40,7 @@ var (
 		vmiMigrationPhaseTransitionTimeFromCreation,
 	}
 
-	vmiMigrationPhaseTransitionTimeFromCreation = operatormetrics.NewHistogramVec(
+	vmiMigrationPhaseTransitionTimeFromCreation = catalog.NewHistogramVec(
 		operatormetrics.MetricOpts{
 			Name: "kubevirt_vmi_migration_phase_transition_time_from_creation_seconds",
 			Help: "Histogram of VM migration phase transitions duration from creation time in seconds.",
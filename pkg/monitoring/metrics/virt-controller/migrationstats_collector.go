@@ -22,6 +22,8 @@ package virtcontroller
 import (
 	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
 	k6tv1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/monitoring/metrics/common/catalog"
 )
 
 var (
@@ -34,7 +36,7 @@ var (
 			succeededMigration,
 			failedMigration,
 		},
-		CollectCallback: migrationStatsCollectorCallback,
+		CollectCallback: whenCachesSynced(migrationStatsCollectorCallback),
 	}
 
 	pendingMigrations = operatormetrics.NewGauge(
@@ -65,7 +67,7 @@ var (
 		},
 	)
 
-	succeededMigration = operatormetrics.NewGaugeVec(
+	succeededMigration = catalog.NewGaugeVec(
 		operatormetrics.MetricOpts{
 			Name: "kubevirt_vmi_migration_succeeded",
 			Help: "Indicates if the VMI migration succeeded.",
@@ -73,7 +75,7 @@ var (
 		[]string{"vmi", "vmim", "namespace"},
 	)
 
-	failedMigration = operatormetrics.NewGaugeVec(
+	failedMigration = catalog.NewGaugeVec(
 		operatormetrics.MetricOpts{
 			Name: "kubevirt_vmi_migration_failed",
 			Help: "Indicates if the VMI migration failed.",
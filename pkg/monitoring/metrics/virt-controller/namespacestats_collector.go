@@ -0,0 +1,181 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtcontroller
+
+import (
+	"sort"
+
+	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
+	k8sv1 "k8s.io/api/core/v1"
+
+	k6tv1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/monitoring/metrics/common/catalog"
+	"kubevirt.io/kubevirt/pkg/util/hardware"
+)
+
+var (
+	namespaceStatsCollector = operatormetrics.Collector{
+		Metrics: []operatormetrics.Metric{
+			namespaceVMRequestedCPUCores,
+			namespaceVMRequestedMemoryBytes,
+			namespaceVMCPUOvercommitRatio,
+			namespaceVMMemoryOvercommitRatio,
+		},
+		CollectCallback: whenCachesSynced(namespaceStatsCollectorCallback),
+	}
+
+	namespaceVMRequestedCPUCores = catalog.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_namespace_vm_requested_cpu_cores",
+			Help: "The total number of CPU cores requested by the running VirtualMachineInstances in the namespace.",
+		},
+		[]string{"namespace"},
+	)
+
+	namespaceVMRequestedMemoryBytes = catalog.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_namespace_vm_requested_memory_bytes",
+			Help: "The total amount of memory in bytes requested by the running VirtualMachineInstances in the namespace.",
+		},
+		[]string{"namespace"},
+	)
+
+	namespaceVMCPUOvercommitRatio = catalog.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_namespace_vm_cpu_overcommit_ratio",
+			Help: "The ratio between the vCPUs and the requested CPU cores of the running VirtualMachineInstances in the namespace.",
+		},
+		[]string{"namespace"},
+	)
+
+	namespaceVMMemoryOvercommitRatio = catalog.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_namespace_vm_memory_overcommit_ratio",
+			Help: "The ratio between the guest memory and the requested memory of the running VirtualMachineInstances in the namespace.",
+		},
+		[]string{"namespace"},
+	)
+)
+
+type namespaceVMResources struct {
+	vCPUs          float64
+	requestedCores float64
+	guestMemory    float64
+	requestedBytes float64
+}
+
+func namespaceStatsCollectorCallback() []operatormetrics.CollectorResult {
+	cachedObjs := stores.VMI.List()
+	vmis := make([]*k6tv1.VirtualMachineInstance, len(cachedObjs))
+	for i, obj := range cachedObjs {
+		vmis[i] = obj.(*k6tv1.VirtualMachineInstance)
+	}
+
+	return reportNamespaceStats(vmis)
+}
+
+func reportNamespaceStats(vmis []*k6tv1.VirtualMachineInstance) []operatormetrics.CollectorResult {
+	resourcesByNamespace := map[string]*namespaceVMResources{}
+	for _, vmi := range vmis {
+		if vmi.Status.Phase != k6tv1.Running {
+			continue
+		}
+
+		resources, ok := resourcesByNamespace[vmi.Namespace]
+		if !ok {
+			resources = &namespaceVMResources{}
+			resourcesByNamespace[vmi.Namespace] = resources
+		}
+
+		vCPUs, requestedCores := getVMICPUResources(vmi)
+		resources.vCPUs += vCPUs
+		resources.requestedCores += requestedCores
+
+		guestMemory, requestedBytes := getVMIMemoryResources(vmi)
+		resources.guestMemory += guestMemory
+		resources.requestedBytes += requestedBytes
+	}
+
+	namespaces := make([]string, 0, len(resourcesByNamespace))
+	for namespace := range resourcesByNamespace {
+		namespaces = append(namespaces, namespace)
+	}
+	sort.Strings(namespaces)
+
+	var crs []operatormetrics.CollectorResult
+	for _, namespace := range namespaces {
+		resources := resourcesByNamespace[namespace]
+		labels := []string{namespace}
+
+		crs = append(crs,
+			operatormetrics.CollectorResult{Metric: namespaceVMRequestedCPUCores, Labels: labels, Value: resources.requestedCores},
+			operatormetrics.CollectorResult{Metric: namespaceVMRequestedMemoryBytes, Labels: labels, Value: resources.requestedBytes},
+		)
+
+		if resources.requestedCores > 0 {
+			crs = append(crs, operatormetrics.CollectorResult{
+				Metric: namespaceVMCPUOvercommitRatio, Labels: labels, Value: resources.vCPUs / resources.requestedCores,
+			})
+		}
+
+		if resources.requestedBytes > 0 {
+			crs = append(crs, operatormetrics.CollectorResult{
+				Metric: namespaceVMMemoryOvercommitRatio, Labels: labels, Value: resources.guestMemory / resources.requestedBytes,
+			})
+		}
+	}
+
+	return crs
+}
+
+// getVMICPUResources returns the vCPUs of the VMI and the CPU cores requested for them.
+// Without an explicit CPU request the launcher pod requests the vCPUs divided by the
+// cluster CPU allocation ratio.
+func getVMICPUResources(vmi *k6tv1.VirtualMachineInstance) (vCPUs, requestedCores float64) {
+	vCPUs = float64(hardware.GetNumberOfVCPUs(vmi.Spec.Domain.CPU))
+
+	if cpuRequest, hasRequest := vmi.Spec.Domain.Resources.Requests[k8sv1.ResourceCPU]; hasRequest {
+		return vCPUs, cpuRequest.AsApproximateFloat64()
+	}
+
+	return vCPUs, vCPUs / getLauncherCPUOvercommit(vmi)
+}
+
+// getVMIMemoryResources returns the guest memory of the VMI and the memory requested for it.
+// Either value falls back to the other when only one of them is set.
+func getVMIMemoryResources(vmi *k6tv1.VirtualMachineInstance) (guestMemory, requestedBytes float64) {
+	if memoryRequest, hasRequest := vmi.Spec.Domain.Resources.Requests[k8sv1.ResourceMemory]; hasRequest {
+		requestedBytes = float64(memoryRequest.Value())
+	}
+
+	if vmi.Spec.Domain.Memory != nil && vmi.Spec.Domain.Memory.Guest != nil {
+		guestMemory = float64(vmi.Spec.Domain.Memory.Guest.Value())
+	}
+
+	if guestMemory == 0 {
+		guestMemory = requestedBytes
+	}
+	if requestedBytes == 0 {
+		requestedBytes = guestMemory
+	}
+
+	return guestMemory, requestedBytes
+}
@@ -0,0 +1,134 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtcontroller
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	k6tv1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("Namespace Stats Collector", func() {
+	newVMI := func(namespace string, phase k6tv1.VirtualMachineInstancePhase, cores uint32, requests k8sv1.ResourceList, guest string) *k6tv1.VirtualMachineInstance {
+		vmi := &k6tv1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "test-vmi"},
+			Spec: k6tv1.VirtualMachineInstanceSpec{
+				Domain: k6tv1.DomainSpec{
+					CPU:       &k6tv1.CPU{Cores: cores, Sockets: 1, Threads: 1},
+					Resources: k6tv1.ResourceRequirements{Requests: requests},
+				},
+			},
+			Status: k6tv1.VirtualMachineInstanceStatus{Phase: phase},
+		}
+		if guest != "" {
+			vmi.Spec.Domain.Memory = &k6tv1.Memory{Guest: pointer.P(resource.MustParse(guest))}
+		}
+		return vmi
+	}
+
+	getValue := func(crs []operatormetrics.CollectorResult, metric operatormetrics.Metric, namespace string) (float64, bool) {
+		for _, cr := range crs {
+			if cr.Metric == metric && cr.Labels[0] == namespace {
+				return cr.Value, true
+			}
+		}
+		return 0, false
+	}
+
+	BeforeEach(func() {
+		clusterConfig, _, _ = testutils.NewFakeClusterConfigUsingKV(&k6tv1.KubeVirt{})
+	})
+
+	It("should not report namespaces without running VMIs", func() {
+		crs := reportNamespaceStats([]*k6tv1.VirtualMachineInstance{
+			newVMI("ns-a", k6tv1.Scheduling, 2, k8sv1.ResourceList{k8sv1.ResourceCPU: resource.MustParse("2")}, "1Gi"),
+			newVMI("ns-a", k6tv1.Succeeded, 2, k8sv1.ResourceList{k8sv1.ResourceCPU: resource.MustParse("2")}, "1Gi"),
+		})
+		Expect(crs).To(BeEmpty())
+	})
+
+	It("should aggregate the requested resources of running VMIs per namespace", func() {
+		crs := reportNamespaceStats([]*k6tv1.VirtualMachineInstance{
+			newVMI("ns-a", k6tv1.Running, 4, k8sv1.ResourceList{
+				k8sv1.ResourceCPU:    resource.MustParse("1"),
+				k8sv1.ResourceMemory: resource.MustParse("1Gi"),
+			}, "2Gi"),
+			newVMI("ns-a", k6tv1.Running, 2, k8sv1.ResourceList{
+				k8sv1.ResourceCPU:    resource.MustParse("1"),
+				k8sv1.ResourceMemory: resource.MustParse("1Gi"),
+			}, ""),
+			newVMI("ns-b", k6tv1.Running, 1, k8sv1.ResourceList{
+				k8sv1.ResourceCPU:    resource.MustParse("500m"),
+				k8sv1.ResourceMemory: resource.MustParse("512Mi"),
+			}, ""),
+		})
+
+		cpu, found := getValue(crs, namespaceVMRequestedCPUCores, "ns-a")
+		Expect(found).To(BeTrue())
+		Expect(cpu).To(Equal(2.0))
+
+		memory, found := getValue(crs, namespaceVMRequestedMemoryBytes, "ns-a")
+		Expect(found).To(BeTrue())
+		Expect(memory).To(Equal(float64(2 * 1024 * 1024 * 1024)))
+
+		cpuRatio, found := getValue(crs, namespaceVMCPUOvercommitRatio, "ns-a")
+		Expect(found).To(BeTrue())
+		Expect(cpuRatio).To(Equal(3.0))
+
+		memoryRatio, found := getValue(crs, namespaceVMMemoryOvercommitRatio, "ns-a")
+		Expect(found).To(BeTrue())
+		Expect(memoryRatio).To(Equal(1.5))
+
+		cpu, found = getValue(crs, namespaceVMRequestedCPUCores, "ns-b")
+		Expect(found).To(BeTrue())
+		Expect(cpu).To(Equal(0.5))
+
+		cpuRatio, found = getValue(crs, namespaceVMCPUOvercommitRatio, "ns-b")
+		Expect(found).To(BeTrue())
+		Expect(cpuRatio).To(Equal(2.0))
+	})
+
+	It("should derive the requested CPU from the CPU allocation ratio when no CPU request is set", func() {
+		crs := reportNamespaceStats([]*k6tv1.VirtualMachineInstance{
+			newVMI("ns-a", k6tv1.Running, 4, nil, "1Gi"),
+		})
+
+		cpu, found := getValue(crs, namespaceVMRequestedCPUCores, "ns-a")
+		Expect(found).To(BeTrue())
+		Expect(cpu).To(Equal(0.4))
+
+		memory, found := getValue(crs, namespaceVMRequestedMemoryBytes, "ns-a")
+		Expect(found).To(BeTrue())
+		Expect(memory).To(Equal(float64(1024 * 1024 * 1024)))
+
+		memoryRatio, found := getValue(crs, namespaceVMMemoryOvercommitRatio, "ns-a")
+		Expect(found).To(BeTrue())
+		Expect(memoryRatio).To(Equal(1.0))
+	})
+})
@@ -20,14 +20,18 @@ package virtcontroller
 
 import (
 	"fmt"
+	"sync"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/cache"
 
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/monitoring/metrics/common/catalog"
 )
 
 const (
@@ -40,9 +44,10 @@ var (
 		vmiPhaseTransition,
 		vmiPhaseTransitionTimeFromCreation,
 		vmiPhaseTransitionFromDeletion,
+		vmiLauncherMemoryOverheadHistogram,
 	}
 
-	vmiPhaseTransition = operatormetrics.NewHistogramVec(
+	vmiPhaseTransition = catalog.NewHistogramVec(
 		operatormetrics.MetricOpts{
 			Name: "kubevirt_vmi_phase_transition_time_seconds",
 			Help: "Histogram of VM phase transitions duration between different phases in seconds.",
@@ -58,7 +63,7 @@ var (
 		},
 	)
 
-	vmiPhaseTransitionTimeFromCreation = operatormetrics.NewHistogramVec(
+	vmiPhaseTransitionTimeFromCreation = catalog.NewHistogramVec(
 		operatormetrics.MetricOpts{
 			Name: "kubevirt_vmi_phase_transition_time_from_creation_seconds",
 			Help: "Histogram of VM phase transitions duration from creation time in seconds.",
@@ -72,7 +77,7 @@ var (
 		},
 	)
 
-	vmiPhaseTransitionFromDeletion = operatormetrics.NewHistogramVec(
+	vmiPhaseTransitionFromDeletion = catalog.NewHistogramVec(
 		operatormetrics.MetricOpts{
 			Name: "kubevirt_vmi_phase_transition_time_from_deletion_seconds",
 			Help: "Histogram of VM phase transitions duration from deletion time in seconds.",
@@ -85,6 +90,19 @@ var (
 			"phase",
 		},
 	)
+
+	vmiLauncherMemoryOverheadHistogram = operatormetrics.NewHistogram(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_launcher_memory_overhead_bytes_histogram",
+			Help: "Histogram of the virt-launcher memory overhead of VMIs, observed once for each running VMI, " +
+				"when it starts running or when virt-controller starts watching it.",
+		},
+		prometheus.HistogramOpts{
+			Buckets: LauncherMemoryOverheadBuckets(),
+		},
+	)
+
+	launcherMemoryOverheadObserver = newLauncherMemoryOverheadTracker()
 )
 
 func AddVMIPhaseTransitionHandlers(informer cache.SharedIndexInformer) error {
@@ -103,6 +121,11 @@ func AddVMIPhaseTransitionHandlers(informer cache.SharedIndexInformer) error {
 		return err
 	}
 
+	err = addVMILauncherMemoryOverheadHandler(informer)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -135,6 +158,23 @@ func addVMIPhaseTransitionTimeFromDeletionHandler(informer cache.SharedIndexInfo
 	return err
 }
 
+func addVMILauncherMemoryOverheadHandler(informer cache.SharedIndexInformer) error {
+	_, err := informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			launcherMemoryOverheadObserver.observe(obj.(*v1.VirtualMachineInstance))
+		},
+		UpdateFunc: func(_, newVMI interface{}) {
+			launcherMemoryOverheadObserver.observe(newVMI.(*v1.VirtualMachineInstance))
+		},
+		DeleteFunc: func(obj interface{}) {
+			if vmi, ok := getDeletedVMI(obj); ok {
+				launcherMemoryOverheadObserver.forget(vmi)
+			}
+		},
+	})
+	return err
+}
+
 func updateVMIPhaseTransitionTime(oldVMI, newVMI *v1.VirtualMachineInstance) {
 	if oldVMI == nil || oldVMI.Status.Phase == newVMI.Status.Phase {
 		return
@@ -202,6 +242,46 @@ func updateVMIPhaseTransitionTimeFromDeletionTime(oldVMI, newVMI *v1.VirtualMach
 	histogram.Observe(diffSeconds)
 }
 
+// launcherMemoryOverheadTracker remembers the VMIs whose launcher memory overhead was observed.
+// Each VMI is observed once, so the distribution is not skewed by long-lived VMIs, whether it is
+// first seen running when it is added to the informer or on an update.
+type launcherMemoryOverheadTracker struct {
+	lock     sync.Mutex
+	observed map[types.NamespacedName]types.UID
+}
+
+func newLauncherMemoryOverheadTracker() *launcherMemoryOverheadTracker {
+	return &launcherMemoryOverheadTracker{
+		observed: map[types.NamespacedName]types.UID{},
+	}
+}
+
+func (t *launcherMemoryOverheadTracker) observe(vmi *v1.VirtualMachineInstance) {
+	if vmi.Status.Phase != v1.Running {
+		return
+	}
+	key := types.NamespacedName{Namespace: vmi.Namespace, Name: vmi.Name}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	// The UID tells a VMI recreated with the same name apart, in case its deletion was missed
+	if uid, exists := t.observed[key]; exists && uid == vmi.UID {
+		return
+	}
+	t.observed[key] = vmi.UID
+	vmiLauncherMemoryOverheadHistogram.Observe(float64(getLauncherMemoryOverheadBytes(vmi)))
+}
+
+func (t *launcherMemoryOverheadTracker) forget(vmi *v1.VirtualMachineInstance) {
+	key := types.NamespacedName{Namespace: vmi.Namespace, Name: vmi.Name}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	delete(t.observed, key)
+}
+
 func getOldTime(fromCreation, fromDeletion bool, oldVMI, newVMI *v1.VirtualMachineInstance) (*metav1.Time, error) {
 	if fromCreation || oldVMI == nil || (oldVMI.Status.Phase == v1.VmPhaseUnset) {
 		return newVMI.CreationTimestamp.DeepCopy(), nil
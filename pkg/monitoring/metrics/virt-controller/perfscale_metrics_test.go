@@ -22,7 +22,10 @@ package virtcontroller
 import (
 	"time"
 
+	ioprometheusclient "github.com/prometheus/client_model/go"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -84,6 +87,65 @@ var _ = Describe("VMI phase transition time histogram", func() {
 	})
 })
 
+var _ = Describe("VMI launcher memory overhead histogram", func() {
+	var tracker *launcherMemoryOverheadTracker
+
+	BeforeEach(func() {
+		tracker = newLauncherMemoryOverheadTracker()
+	})
+
+	getSampleCount := func() uint64 {
+		metric := &ioprometheusclient.Metric{}
+		Expect(vmiLauncherMemoryOverheadHistogram.Write(metric)).To(Succeed())
+		return metric.GetHistogram().GetSampleCount()
+	}
+
+	newVMI := func(uid types.UID, phase v1.VirtualMachineInstancePhase) *v1.VirtualMachineInstance {
+		overhead := resource.MustParse("200Mi")
+		return &v1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test-ns", Name: "testvmi", UID: uid},
+			Status: v1.VirtualMachineInstanceStatus{
+				Phase:  phase,
+				Memory: &v1.MemoryStatus{MemoryOverhead: &overhead},
+			},
+		}
+	}
+
+	DescribeTable("should observe the overhead", func(phases []v1.VirtualMachineInstancePhase, expectedObservations uint64) {
+		before := getSampleCount()
+		for _, phase := range phases {
+			tracker.observe(newVMI("vmi-uid", phase))
+		}
+		Expect(getSampleCount() - before).To(Equal(expectedObservations))
+	},
+		Entry("when the VMI starts running",
+			[]v1.VirtualMachineInstancePhase{v1.Scheduled, v1.Running}, uint64(1)),
+		Entry("when the VMI is already running when it is added",
+			[]v1.VirtualMachineInstancePhase{v1.Running}, uint64(1)),
+		Entry("once while the VMI keeps running",
+			[]v1.VirtualMachineInstancePhase{v1.Running, v1.Running, v1.Running}, uint64(1)),
+		Entry("not when the VMI is not running yet",
+			[]v1.VirtualMachineInstancePhase{v1.Scheduling, v1.Scheduled}, uint64(0)),
+		Entry("not again when the VMI stops running",
+			[]v1.VirtualMachineInstancePhase{v1.Running, v1.Failed}, uint64(1)),
+	)
+
+	It("should observe a VMI recreated with the same name", func() {
+		before := getSampleCount()
+		tracker.observe(newVMI("vmi-uid", v1.Running))
+		tracker.observe(newVMI("recreated-vmi-uid", v1.Running))
+		Expect(getSampleCount() - before).To(Equal(uint64(2)))
+	})
+
+	It("should forget a deleted VMI", func() {
+		tracker.observe(newVMI("vmi-uid", v1.Running))
+		Expect(tracker.observed).To(HaveLen(1))
+
+		tracker.forget(newVMI("vmi-uid", v1.Running))
+		Expect(tracker.observed).To(BeEmpty())
+	})
+})
+
 func createVMISForPhaseTransitionTime(
 	phase, oldPhase v1.VirtualMachineInstancePhase,
 	offset float64,
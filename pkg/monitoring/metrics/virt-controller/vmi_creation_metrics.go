@@ -0,0 +1,44 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtcontroller
+
+import (
+	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
+
+	"kubevirt.io/kubevirt/pkg/monitoring/metrics/common/catalog"
+)
+
+var (
+	vmiCreationMetrics = []operatormetrics.Metric{
+		vmiCreationBlocked,
+	}
+
+	vmiCreationBlocked = catalog.NewCounterVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_creation_blocked_total",
+			Help: "Total number of virt-launcher pod creation attempts rejected by a ResourceQuota or a LimitRange.",
+		},
+		[]string{"reason"},
+	)
+)
+
+func VMICreationBlocked(reason string) {
+	vmiCreationBlocked.WithLabelValues(reason).Inc()
+}
@@ -0,0 +1,50 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtcontroller
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	ioprometheusclient "github.com/prometheus/client_model/go"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+var _ = Describe("VMI creation blocked counter", func() {
+	BeforeEach(func() {
+		vmiCreationBlocked.Reset()
+	})
+
+	getCounterValue := func(reason string) float64 {
+		metric := &ioprometheusclient.Metric{}
+		Expect(vmiCreationBlocked.WithLabelValues(reason).Write(metric)).To(Succeed())
+		return metric.GetCounter().GetValue()
+	}
+
+	It("should count blocked creations by reason", func() {
+		VMICreationBlocked(v1.VirtualMachineInstanceReasonResourceQuotaExceeded)
+		VMICreationBlocked(v1.VirtualMachineInstanceReasonResourceQuotaExceeded)
+		VMICreationBlocked(v1.VirtualMachineInstanceReasonLimitRangeViolated)
+
+		Expect(getCounterValue(v1.VirtualMachineInstanceReasonResourceQuotaExceeded)).To(Equal(2.0))
+		Expect(getCounterValue(v1.VirtualMachineInstanceReasonLimitRangeViolated)).To(Equal(1.0))
+	})
+})
@@ -0,0 +1,404 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ */
+
+package virtcontroller
+
+import (
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/monitoring/metrics/common/catalog"
+	"kubevirt.io/kubevirt/pkg/network/vmispec"
+	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
+)
+
+var (
+	vmiHotplugMetrics = []operatormetrics.Metric{
+		vmiEphemeralHotplugVolumeCreated,
+		vmiEphemeralHotplugVolumeLimitReached,
+		vmiHotplugVolumeAttachDuration,
+		vmiHotplugVolumeDetachDuration,
+		vmiHotplugVolumeErrors,
+		vmiInterfaceHotplugRequests,
+		vmiInterfaceHotplugDuration,
+	}
+
+	vmiEphemeralHotplugVolumeCreated = catalog.NewCounterVec(
+		catalog.Opts(operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_ephemeral_hotplug_volume_created_total",
+			Help: "Total number of ephemeral hotplug volumes attached to the VirtualMachineInstance over its lifetime.",
+		},
+			catalog.WithStabilityLevel(catalog.Alpha),
+		),
+		[]string{"namespace", "name"},
+	)
+
+	vmiEphemeralHotplugVolumeLimitReached = catalog.NewCounterVec(
+		catalog.Opts(operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_ephemeral_hotplug_volume_limit_reached_total",
+			Help: "Total number of ephemeral hotplug volumes held because the maximum number of " +
+				"ephemeral hotplug volumes per VirtualMachineInstance was reached.",
+		},
+			catalog.WithStabilityLevel(catalog.Alpha),
+		),
+		[]string{"namespace"},
+	)
+
+	vmiHotplugVolumeAttachDuration = operatormetrics.NewHistogram(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_hotplug_volume_attach_duration_seconds",
+			Help: "Histogram of the time from a hotplug volume being added to the VirtualMachineInstance spec " +
+				"until its volume status is Ready, in seconds.",
+		},
+		prometheus.HistogramOpts{
+			Buckets: PhaseTransitionTimeBuckets(),
+		},
+	)
+
+	vmiHotplugVolumeDetachDuration = operatormetrics.NewHistogram(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_hotplug_volume_detach_duration_seconds",
+			Help: "Histogram of the time from a hotplug volume being removed from the VirtualMachineInstance spec " +
+				"until its volume status is removed, in seconds.",
+		},
+		prometheus.HistogramOpts{
+			Buckets: PhaseTransitionTimeBuckets(),
+		},
+	)
+
+	vmiHotplugVolumeErrors = catalog.NewCounterVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_hotplug_volume_errors_total",
+			Help: "Total number of errors encountered while attaching or detaching hotplug volumes.",
+		},
+		[]string{"operation", "reason"},
+	)
+
+	vmiInterfaceHotplugRequests = catalog.NewCounterVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_interface_hotplug_total",
+			Help: "Total number of network interface hotplug and hotunplug requests applied to VirtualMachineInstances, " +
+				"by operation and status.",
+		},
+		[]string{"operation", "status"},
+	)
+
+	vmiInterfaceHotplugDuration = catalog.NewHistogramVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_interface_hotplug_duration_seconds",
+			Help: "Histogram of the time from a network interface hotplug or hotunplug request being applied to the " +
+				"VirtualMachineInstance spec until the interface status reflects it, in seconds.",
+		},
+		prometheus.HistogramOpts{
+			Buckets: PhaseTransitionTimeBuckets(),
+		},
+		[]string{"operation"},
+	)
+
+	volumeHotplugLatencySource = hotplugLatencySource{
+		requested:      getHotplugVolumeNames,
+		attached:       getHotplugVolumesReady,
+		attachDuration: vmiHotplugVolumeAttachDuration,
+		detachDuration: vmiHotplugVolumeDetachDuration,
+	}
+
+	interfaceHotplugLatencySource = hotplugLatencySource{
+		requested:      getPluggedInterfaceNames,
+		attached:       getInterfacesInDomain,
+		attachDuration: histogramVecObserver{vec: vmiInterfaceHotplugDuration, label: interfacePlugOperation},
+		detachDuration: histogramVecObserver{vec: vmiInterfaceHotplugDuration, label: interfaceUnplugOperation},
+	}
+
+	hotplugLatency          = newHotplugLatencyTracker(time.Now, volumeHotplugLatencySource)
+	interfaceHotplugLatency = newHotplugLatencyTracker(time.Now, interfaceHotplugLatencySource)
+)
+
+const (
+	interfacePlugOperation   = "plug"
+	interfaceUnplugOperation = "unplug"
+
+	interfaceHotplugSucceeded = "succeeded"
+	interfaceHotplugFailed    = "failed"
+)
+
+// hotplugLatencySource describes a kind of hotplugged device for the hotplugLatencyTracker.
+// requested lists the devices the VMI spec asks to be attached, attached reports the
+// devices present in the VMI status and whether they are fully attached.
+type hotplugLatencySource struct {
+	requested      func(vmi *v1.VirtualMachineInstance) []string
+	attached       func(vmi *v1.VirtualMachineInstance) map[string]bool
+	attachDuration prometheus.Observer
+	detachDuration prometheus.Observer
+}
+
+// histogramVecObserver resolves its label on every observation, so it keeps observing into
+// the histogram vector after setDurationHistogramBuckets recreates it.
+type histogramVecObserver struct {
+	vec   *operatormetrics.HistogramVec
+	label string
+}
+
+func (o histogramVecObserver) Observe(value float64) {
+	o.vec.WithLabelValues(o.label).Observe(value)
+}
+
+// hotplugLatencyTracker remembers when hotplugged devices were added to or removed
+// from a VMI spec until the matching status change is observed, since a single
+// informer update only carries the two latest versions of the VMI.
+type hotplugLatencyTracker struct {
+	lock            sync.Mutex
+	now             func() time.Time
+	source          hotplugLatencySource
+	attachRequested map[types.NamespacedName]map[string]time.Time
+	detachRequested map[types.NamespacedName]map[string]time.Time
+}
+
+func newHotplugLatencyTracker(now func() time.Time, source hotplugLatencySource) *hotplugLatencyTracker {
+	return &hotplugLatencyTracker{
+		now:             now,
+		source:          source,
+		attachRequested: map[types.NamespacedName]map[string]time.Time{},
+		detachRequested: map[types.NamespacedName]map[string]time.Time{},
+	}
+}
+
+func HotplugVolumeAttachFailed(reason string) {
+	vmiHotplugVolumeErrors.WithLabelValues("attach", reason).Inc()
+}
+
+func EphemeralHotplugVolumeHeld(namespace string) {
+	vmiEphemeralHotplugVolumeLimitReached.WithLabelValues(namespace).Inc()
+}
+
+func HotplugVolumeDetachFailed(reason string) {
+	vmiHotplugVolumeErrors.WithLabelValues("detach", reason).Inc()
+}
+
+func HotplugInterfaceSucceeded() {
+	vmiInterfaceHotplugRequests.WithLabelValues(interfacePlugOperation, interfaceHotplugSucceeded).Inc()
+}
+
+func HotplugInterfaceFailed() {
+	vmiInterfaceHotplugRequests.WithLabelValues(interfacePlugOperation, interfaceHotplugFailed).Inc()
+}
+
+func HotunplugInterfaceSucceeded() {
+	vmiInterfaceHotplugRequests.WithLabelValues(interfaceUnplugOperation, interfaceHotplugSucceeded).Inc()
+}
+
+func HotunplugInterfaceFailed() {
+	vmiInterfaceHotplugRequests.WithLabelValues(interfaceUnplugOperation, interfaceHotplugFailed).Inc()
+}
+
+func AddVMIHotplugHandlers(informer cache.SharedIndexInformer) error {
+	err := addVMIEphemeralHotplugHandler(informer)
+	if err != nil {
+		return err
+	}
+
+	for _, tracker := range []vmiHotplugTracker{hotplugLatency, interfaceHotplugLatency, cpuHotplugTracker, memoryHotplugTracker} {
+		if err := addVMIHotplugTrackerHandler(informer, tracker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func addVMIEphemeralHotplugHandler(informer cache.SharedIndexInformer) error {
+	_, err := informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(oldVMI, newVMI interface{}) {
+			updateVMIEphemeralHotplugVolumeCreated(oldVMI.(*v1.VirtualMachineInstance), newVMI.(*v1.VirtualMachineInstance))
+		},
+		DeleteFunc: func(obj interface{}) {
+			if vmi, ok := getDeletedVMI(obj); ok {
+				vmiEphemeralHotplugVolumeCreated.DeleteLabelValues(vmi.Namespace, vmi.Name)
+			}
+		},
+	})
+	return err
+}
+
+// vmiHotplugTracker follows hotplug operations across VMI updates.
+type vmiHotplugTracker interface {
+	update(oldVMI, newVMI *v1.VirtualMachineInstance)
+	forget(vmi *v1.VirtualMachineInstance)
+}
+
+func addVMIHotplugTrackerHandler(informer cache.SharedIndexInformer, tracker vmiHotplugTracker) error {
+	_, err := informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(oldVMI, newVMI interface{}) {
+			tracker.update(oldVMI.(*v1.VirtualMachineInstance), newVMI.(*v1.VirtualMachineInstance))
+		},
+		DeleteFunc: func(obj interface{}) {
+			if vmi, ok := getDeletedVMI(obj); ok {
+				tracker.forget(vmi)
+			}
+		},
+	})
+	return err
+}
+
+func getDeletedVMI(obj interface{}) (*v1.VirtualMachineInstance, bool) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	vmi, ok := obj.(*v1.VirtualMachineInstance)
+	return vmi, ok
+}
+
+func updateVMIEphemeralHotplugVolumeCreated(oldVMI, newVMI *v1.VirtualMachineInstance) {
+	newVolumes, err := getEphemeralHotplugVolumes(newVMI)
+	if err != nil || len(newVolumes) == 0 {
+		return
+	}
+
+	// Volumes already listed in the old annotation were counted when they first appeared
+	oldVolumes, _ := getEphemeralHotplugVolumes(oldVMI)
+
+	added := 0
+	for _, volume := range newVolumes {
+		if !slices.Contains(oldVolumes, volume) {
+			added++
+		}
+	}
+
+	if added == 0 {
+		return
+	}
+
+	counter, err := vmiEphemeralHotplugVolumeCreated.GetMetricWithLabelValues(newVMI.Namespace, newVMI.Name)
+	if err != nil {
+		log.Log.Reason(err).Errorf("failed to get ephemeral hotplug volume counter for vmi %s/%s", newVMI.Namespace, newVMI.Name)
+		return
+	}
+	counter.Add(float64(added))
+}
+
+func (t *hotplugLatencyTracker) update(oldVMI, newVMI *v1.VirtualMachineInstance) {
+	key := types.NamespacedName{Namespace: newVMI.Namespace, Name: newVMI.Name}
+	oldDevices := t.source.requested(oldVMI)
+	newDevices := t.source.requested(newVMI)
+	now := t.now()
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	for _, device := range newDevices {
+		if !slices.Contains(oldDevices, device) {
+			t.request(t.attachRequested, key, device, now)
+			delete(t.detachRequested[key], device)
+		}
+	}
+	for _, device := range oldDevices {
+		if !slices.Contains(newDevices, device) {
+			t.request(t.detachRequested, key, device, now)
+			delete(t.attachRequested[key], device)
+		}
+	}
+
+	attached := t.source.attached(newVMI)
+
+	for device, requested := range t.attachRequested[key] {
+		if attached[device] {
+			t.source.attachDuration.Observe(now.Sub(requested).Seconds())
+			delete(t.attachRequested[key], device)
+		}
+	}
+	for device, requested := range t.detachRequested[key] {
+		if _, exists := attached[device]; !exists {
+			t.source.detachDuration.Observe(now.Sub(requested).Seconds())
+			delete(t.detachRequested[key], device)
+		}
+	}
+
+	t.cleanup(key)
+}
+
+func (t *hotplugLatencyTracker) request(requests map[types.NamespacedName]map[string]time.Time, key types.NamespacedName, device string, now time.Time) {
+	if requests[key] == nil {
+		requests[key] = map[string]time.Time{}
+	}
+	if _, exists := requests[key][device]; !exists {
+		requests[key][device] = now
+	}
+}
+
+func (t *hotplugLatencyTracker) cleanup(key types.NamespacedName) {
+	if len(t.attachRequested[key]) == 0 {
+		delete(t.attachRequested, key)
+	}
+	if len(t.detachRequested[key]) == 0 {
+		delete(t.detachRequested, key)
+	}
+}
+
+func (t *hotplugLatencyTracker) forget(vmi *v1.VirtualMachineInstance) {
+	key := types.NamespacedName{Namespace: vmi.Namespace, Name: vmi.Name}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	delete(t.attachRequested, key)
+	delete(t.detachRequested, key)
+}
+
+func getHotplugVolumeNames(vmi *v1.VirtualMachineInstance) []string {
+	var names []string
+	for i := range vmi.Spec.Volumes {
+		if storagetypes.IsHotplugVolume(&vmi.Spec.Volumes[i]) {
+			names = append(names, vmi.Spec.Volumes[i].Name)
+		}
+	}
+	return names
+}
+
+func getHotplugVolumesReady(vmi *v1.VirtualMachineInstance) map[string]bool {
+	ready := map[string]bool{}
+	for _, volumeStatus := range vmi.Status.VolumeStatus {
+		ready[volumeStatus.Name] = volumeStatus.Phase == v1.VolumeReady
+	}
+	return ready
+}
+
+func getPluggedInterfaceNames(vmi *v1.VirtualMachineInstance) []string {
+	var names []string
+	for _, iface := range vmi.Spec.Domain.Devices.Interfaces {
+		if iface.State != v1.InterfaceStateAbsent {
+			names = append(names, iface.Name)
+		}
+	}
+	return names
+}
+
+func getInterfacesInDomain(vmi *v1.VirtualMachineInstance) map[string]bool {
+	inDomain := map[string]bool{}
+	for _, ifaceStatus := range vmi.Status.Interfaces {
+		inDomain[ifaceStatus.Name] = vmispec.ContainsInfoSource(ifaceStatus.InfoSource, vmispec.InfoSourceDomain)
+	}
+	return inDomain
+}
@@ -0,0 +1,285 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+package virtcontroller
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/prometheus/client_golang/prometheus"
+	ioprometheusclient "github.com/prometheus/client_model/go"
+	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/vmispec"
+)
+
+var _ = Describe("VMI ephemeral hotplug volume created counter", func() {
+	BeforeEach(func() {
+		vmiEphemeralHotplugVolumeCreated.Reset()
+	})
+
+	newVMI := func(ephemeralVolumes string) *v1.VirtualMachineInstance {
+		vmi := &v1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "test-ns",
+				Name:      "test-vmi",
+			},
+		}
+		if ephemeralVolumes != "" {
+			vmi.Annotations = map[string]string{v1.EphemeralHotplugAnnotation: ephemeralVolumes}
+		}
+		return vmi
+	}
+
+	getCounterValue := func() float64 {
+		metric := &ioprometheusclient.Metric{}
+		counter, err := vmiEphemeralHotplugVolumeCreated.GetMetricWithLabelValues("test-ns", "test-vmi")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(counter.Write(metric)).To(Succeed())
+		return metric.GetCounter().GetValue()
+	}
+
+	DescribeTable("should count newly attached ephemeral hotplug volumes", func(oldVolumes, newVolumes string, expectedValue float64) {
+		updateVMIEphemeralHotplugVolumeCreated(newVMI(oldVolumes), newVMI(newVolumes))
+		Expect(getCounterValue()).To(Equal(expectedValue))
+	},
+		Entry("when the first volume is attached", "", `["vol1"]`, 1.0),
+		Entry("when several volumes are attached at once", "", `["vol1","vol2"]`, 2.0),
+		Entry("when a volume is added to the existing ones", `["vol1"]`, `["vol1","vol2"]`, 1.0),
+		Entry("not when the volumes are unchanged", `["vol1"]`, `["vol1"]`, 0.0),
+		Entry("not when a volume is unplugged", `["vol1","vol2"]`, `["vol1"]`, 0.0),
+		Entry("not when all volumes are unplugged", `["vol1"]`, "", 0.0),
+		Entry("not when the annotation cannot be parsed", "", "not-json", 0.0),
+	)
+
+	It("should keep counting across unplug and replug", func() {
+		updateVMIEphemeralHotplugVolumeCreated(newVMI(""), newVMI(`["vol1"]`))
+		updateVMIEphemeralHotplugVolumeCreated(newVMI(`["vol1"]`), newVMI(`["vol1"]`))
+		updateVMIEphemeralHotplugVolumeCreated(newVMI(`["vol1"]`), newVMI(""))
+		updateVMIEphemeralHotplugVolumeCreated(newVMI(""), newVMI(`["vol1"]`))
+
+		Expect(getCounterValue()).To(Equal(2.0))
+	})
+})
+
+var _ = Describe("VMI hotplug volume latency histograms", func() {
+	var (
+		now     time.Time
+		tracker *hotplugLatencyTracker
+	)
+
+	BeforeEach(func() {
+		now = time.Now()
+		tracker = newHotplugLatencyTracker(func() time.Time { return now }, volumeHotplugLatencySource)
+	})
+
+	getHistogram := func(histogram *operatormetrics.Histogram) *ioprometheusclient.Histogram {
+		metric := &ioprometheusclient.Metric{}
+		Expect(histogram.Write(metric)).To(Succeed())
+		return metric.GetHistogram()
+	}
+
+	newVMI := func(volumes []v1.Volume, volumeStatus map[string]v1.VolumePhase) *v1.VirtualMachineInstance {
+		vmi := &v1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "test-ns",
+				Name:      "test-vmi",
+			},
+			Spec: v1.VirtualMachineInstanceSpec{
+				Volumes: volumes,
+			},
+		}
+		for name, phase := range volumeStatus {
+			vmi.Status.VolumeStatus = append(vmi.Status.VolumeStatus, v1.VolumeStatus{Name: name, Phase: phase})
+		}
+		return vmi
+	}
+
+	hotplugVolume := v1.Volume{
+		Name: "hotplug",
+		VolumeSource: v1.VolumeSource{
+			PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{Hotpluggable: true},
+		},
+	}
+
+	It("should observe the time until an added hotplug volume is ready", func() {
+		before := getHistogram(vmiHotplugVolumeAttachDuration)
+
+		tracker.update(newVMI(nil, nil), newVMI([]v1.Volume{hotplugVolume}, nil))
+		now = now.Add(2 * time.Second)
+		tracker.update(newVMI([]v1.Volume{hotplugVolume}, nil), newVMI([]v1.Volume{hotplugVolume}, map[string]v1.VolumePhase{"hotplug": v1.HotplugVolumeAttachedToNode}))
+		now = now.Add(3 * time.Second)
+		tracker.update(newVMI([]v1.Volume{hotplugVolume}, nil), newVMI([]v1.Volume{hotplugVolume}, map[string]v1.VolumePhase{"hotplug": v1.VolumeReady}))
+
+		after := getHistogram(vmiHotplugVolumeAttachDuration)
+		Expect(after.GetSampleCount() - before.GetSampleCount()).To(Equal(uint64(1)))
+		Expect(after.GetSampleSum() - before.GetSampleSum()).To(BeNumerically("~", 5.0))
+		Expect(tracker.attachRequested).To(BeEmpty())
+	})
+
+	It("should observe the time until a removed hotplug volume status is gone", func() {
+		before := getHistogram(vmiHotplugVolumeDetachDuration)
+
+		ready := map[string]v1.VolumePhase{"hotplug": v1.VolumeReady}
+		tracker.update(newVMI([]v1.Volume{hotplugVolume}, ready), newVMI(nil, map[string]v1.VolumePhase{"hotplug": v1.HotplugVolumeDetaching}))
+		now = now.Add(4 * time.Second)
+		tracker.update(newVMI(nil, ready), newVMI(nil, nil))
+
+		after := getHistogram(vmiHotplugVolumeDetachDuration)
+		Expect(after.GetSampleCount() - before.GetSampleCount()).To(Equal(uint64(1)))
+		Expect(after.GetSampleSum() - before.GetSampleSum()).To(BeNumerically("~", 4.0))
+		Expect(tracker.detachRequested).To(BeEmpty())
+	})
+
+	It("should ignore volumes that are not hotplugged", func() {
+		volume := v1.Volume{
+			Name: "disk",
+			VolumeSource: v1.VolumeSource{
+				PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{},
+			},
+		}
+
+		tracker.update(newVMI(nil, nil), newVMI([]v1.Volume{volume}, nil))
+		Expect(tracker.attachRequested).To(BeEmpty())
+	})
+
+	It("should forget pending volumes of a deleted VMI", func() {
+		before := getHistogram(vmiHotplugVolumeAttachDuration)
+
+		tracker.update(newVMI(nil, nil), newVMI([]v1.Volume{hotplugVolume}, nil))
+		tracker.forget(newVMI(nil, nil))
+		tracker.update(newVMI([]v1.Volume{hotplugVolume}, nil), newVMI([]v1.Volume{hotplugVolume}, map[string]v1.VolumePhase{"hotplug": v1.VolumeReady}))
+
+		after := getHistogram(vmiHotplugVolumeAttachDuration)
+		Expect(after.GetSampleCount()).To(Equal(before.GetSampleCount()))
+		Expect(tracker.attachRequested).To(BeEmpty())
+	})
+})
+
+var _ = Describe("VMI hotplug volume errors counter", func() {
+	BeforeEach(func() {
+		vmiHotplugVolumeErrors.Reset()
+	})
+
+	getCounterValue := func(operation, reason string) float64 {
+		metric := &ioprometheusclient.Metric{}
+		Expect(vmiHotplugVolumeErrors.WithLabelValues(operation, reason).Write(metric)).To(Succeed())
+		return metric.GetCounter().GetValue()
+	}
+
+	It("should count attach and detach errors separately", func() {
+		HotplugVolumeAttachFailed("FailedCreate")
+		HotplugVolumeAttachFailed("FailedCreate")
+		HotplugVolumeDetachFailed("FailedDelete")
+
+		Expect(getCounterValue("attach", "FailedCreate")).To(Equal(2.0))
+		Expect(getCounterValue("detach", "FailedDelete")).To(Equal(1.0))
+		Expect(getCounterValue("detach", "FailedCreate")).To(BeZero())
+	})
+})
+
+var _ = Describe("VMI interface hotplug metrics", func() {
+	var (
+		now     time.Time
+		tracker *hotplugLatencyTracker
+	)
+
+	BeforeEach(func() {
+		now = time.Now()
+		tracker = newHotplugLatencyTracker(func() time.Time { return now }, interfaceHotplugLatencySource)
+		vmiInterfaceHotplugRequests.Reset()
+	})
+
+	getHistogram := func(operation string) *ioprometheusclient.Histogram {
+		metric := &ioprometheusclient.Metric{}
+		Expect(vmiInterfaceHotplugDuration.WithLabelValues(operation).(prometheus.Histogram).Write(metric)).To(Succeed())
+		return metric.GetHistogram()
+	}
+
+	getCounterValue := func(operation, status string) float64 {
+		metric := &ioprometheusclient.Metric{}
+		Expect(vmiInterfaceHotplugRequests.WithLabelValues(operation, status).Write(metric)).To(Succeed())
+		return metric.GetCounter().GetValue()
+	}
+
+	newVMI := func(ifaces []v1.Interface, ifaceInfoSources map[string]string) *v1.VirtualMachineInstance {
+		vmi := &v1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "test-ns",
+				Name:      "test-vmi",
+			},
+		}
+		vmi.Spec.Domain.Devices.Interfaces = ifaces
+		for name, infoSource := range ifaceInfoSources {
+			vmi.Status.Interfaces = append(vmi.Status.Interfaces, v1.VirtualMachineInstanceNetworkInterface{Name: name, InfoSource: infoSource})
+		}
+		return vmi
+	}
+
+	plugged := []v1.Interface{{Name: "blue"}}
+	unplugged := []v1.Interface{{Name: "blue", State: v1.InterfaceStateAbsent}}
+
+	It("should observe the time until a plugged interface is reported by the domain", func() {
+		before := getHistogram("plug")
+
+		tracker.update(newVMI(nil, nil), newVMI(plugged, nil))
+		now = now.Add(2 * time.Second)
+		tracker.update(newVMI(plugged, nil), newVMI(plugged, map[string]string{"blue": vmispec.InfoSourceMultusStatus}))
+		now = now.Add(3 * time.Second)
+		tracker.update(newVMI(plugged, nil), newVMI(plugged, map[string]string{
+			"blue": vmispec.NewInfoSource(vmispec.InfoSourceMultusStatus, vmispec.InfoSourceDomain),
+		}))
+
+		after := getHistogram("plug")
+		Expect(after.GetSampleCount() - before.GetSampleCount()).To(Equal(uint64(1)))
+		Expect(after.GetSampleSum() - before.GetSampleSum()).To(BeNumerically("~", 5.0))
+		Expect(tracker.attachRequested).To(BeEmpty())
+	})
+
+	It("should observe the time until an unplugged interface status is gone", func() {
+		before := getHistogram("unplug")
+
+		inDomain := map[string]string{"blue": vmispec.InfoSourceDomain}
+		tracker.update(newVMI(plugged, inDomain), newVMI(unplugged, inDomain))
+		now = now.Add(4 * time.Second)
+		tracker.update(newVMI(unplugged, inDomain), newVMI(unplugged, nil))
+
+		after := getHistogram("unplug")
+		Expect(after.GetSampleCount() - before.GetSampleCount()).To(Equal(uint64(1)))
+		Expect(after.GetSampleSum() - before.GetSampleSum()).To(BeNumerically("~", 4.0))
+		Expect(tracker.detachRequested).To(BeEmpty())
+	})
+
+	It("should count plug and unplug requests by status", func() {
+		HotplugInterfaceSucceeded()
+		HotplugInterfaceFailed()
+		HotplugInterfaceFailed()
+		HotunplugInterfaceSucceeded()
+
+		Expect(getCounterValue("plug", "succeeded")).To(Equal(1.0))
+		Expect(getCounterValue("plug", "failed")).To(Equal(2.0))
+		Expect(getCounterValue("unplug", "succeeded")).To(Equal(1.0))
+		Expect(getCounterValue("unplug", "failed")).To(BeZero())
+	})
+})
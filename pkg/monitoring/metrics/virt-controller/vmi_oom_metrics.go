@@ -0,0 +1,150 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+package virtcontroller
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/monitoring/metrics/common/catalog"
+)
+
+// launcherOOMScope is the only reported scope, OOM kills inside the guest are not
+// reported by libvirt nor by the guest agent.
+const launcherOOMScope = "launcher"
+
+var (
+	vmiOOMMetrics = []operatormetrics.Metric{
+		vmiOOMEvents,
+	}
+
+	vmiOOMEvents = catalog.NewCounterVec(
+		catalog.Opts(operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_oom_events_total",
+			Help: "Total number of OOM kills of the containers in the virt-launcher pods of the VirtualMachineInstance, by scope. " +
+				"Only the launcher scope is reported, OOM kills inside the guest are not visible to KubeVirt.",
+		},
+			catalog.WithStabilityLevel(catalog.Alpha),
+			catalog.WithLabelDescription("scope", "Where the OOM kill happened. Always 'launcher'."),
+		),
+		[]string{"namespace", "name", "scope"},
+	)
+
+	oomEvents = newOOMEventTracker()
+)
+
+func AddVMIOOMHandlers(vmiInformer, podInformer cache.SharedIndexInformer) error {
+	_, err := podInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(oldPod, newPod interface{}) {
+			oomEvents.update(oldPod.(*k8sv1.Pod), newPod.(*k8sv1.Pod))
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = vmiInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		DeleteFunc: func(obj interface{}) {
+			if vmi, ok := getDeletedVMI(obj); ok {
+				oomEvents.forget(vmi)
+			}
+		},
+	})
+	return err
+}
+
+// oomEventTracker counts the OOM kills of the launcher pod containers, remembering the
+// terminations already counted for each VMI.
+type oomEventTracker struct {
+	lock sync.Mutex
+	seen map[types.NamespacedName]map[string]struct{}
+}
+
+func newOOMEventTracker() *oomEventTracker {
+	return &oomEventTracker{
+		seen: map[types.NamespacedName]map[string]struct{}{},
+	}
+}
+
+// update counts the OOM terminations that appear in the new pod. A container that restarts
+// moves its termination from State to LastTerminationState with a bumped RestartCount, the
+// termination keeps its container ID and finish time so it is not counted twice.
+func (t *oomEventTracker) update(oldPod, newPod *k8sv1.Pod) {
+	if newPod.Labels[v1.AppLabel] != "virt-launcher" {
+		return
+	}
+	vmiName, exists := newPod.Annotations[v1.DomainAnnotation]
+	if !exists {
+		return
+	}
+
+	// Terminations already present in the old pod happened before this update was observed
+	oldTerminations := getOOMTerminations(oldPod)
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	key := types.NamespacedName{Namespace: newPod.Namespace, Name: vmiName}
+	for termination := range getOOMTerminations(newPod) {
+		if _, exists := oldTerminations[termination]; exists {
+			continue
+		}
+		if _, exists := t.seen[key][termination]; exists {
+			continue
+		}
+
+		if t.seen[key] == nil {
+			t.seen[key] = map[string]struct{}{}
+		}
+		t.seen[key][termination] = struct{}{}
+		vmiOOMEvents.WithLabelValues(newPod.Namespace, vmiName, launcherOOMScope).Inc()
+	}
+}
+
+func (t *oomEventTracker) forget(vmi *v1.VirtualMachineInstance) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	delete(t.seen, types.NamespacedName{Namespace: vmi.Namespace, Name: vmi.Name})
+	vmiOOMEvents.DeletePartialMatch(prometheus.Labels{"namespace": vmi.Namespace, "name": vmi.Name})
+}
+
+func getOOMTerminations(pod *k8sv1.Pod) map[string]struct{} {
+	terminations := map[string]struct{}{}
+	for _, containerStatus := range pod.Status.ContainerStatuses {
+		for _, state := range []k8sv1.ContainerState{containerStatus.State, containerStatus.LastTerminationState} {
+			if state.Terminated == nil || state.Terminated.Reason != "OOMKilled" {
+				continue
+			}
+			termination := fmt.Sprintf("%s/%s/%s/%s", pod.UID, containerStatus.Name,
+				state.Terminated.ContainerID, state.Terminated.FinishedAt.UTC().Format(time.RFC3339))
+			terminations[termination] = struct{}{}
+		}
+	}
+	return terminations
+}
@@ -0,0 +1,146 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+package virtcontroller
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/prometheus/client_golang/prometheus"
+	ioprometheusclient "github.com/prometheus/client_model/go"
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+var _ = Describe("VMI OOM events counter", func() {
+	var tracker *oomEventTracker
+
+	BeforeEach(func() {
+		vmiOOMEvents.Reset()
+		tracker = newOOMEventTracker()
+	})
+
+	getCounterValue := func() float64 {
+		metric := &ioprometheusclient.Metric{}
+		Expect(vmiOOMEvents.WithLabelValues("test-ns", "test-vmi", launcherOOMScope).Write(metric)).To(Succeed())
+		return metric.GetCounter().GetValue()
+	}
+
+	oomKilled := func(containerID string) k8sv1.ContainerState {
+		return k8sv1.ContainerState{
+			Terminated: &k8sv1.ContainerStateTerminated{
+				Reason:      "OOMKilled",
+				ContainerID: containerID,
+				FinishedAt:  metav1.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		}
+	}
+
+	newLauncherPod := func(containerStatuses ...k8sv1.ContainerStatus) *k8sv1.Pod {
+		return &k8sv1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   "test-ns",
+				Name:        "virt-launcher-test-vmi",
+				UID:         "test-pod-uid",
+				Labels:      map[string]string{v1.AppLabel: "virt-launcher"},
+				Annotations: map[string]string{v1.DomainAnnotation: "test-vmi"},
+			},
+			Status: k8sv1.PodStatus{
+				ContainerStatuses: containerStatuses,
+			},
+		}
+	}
+
+	It("should count an OOM kill of a launcher container", func() {
+		tracker.update(
+			newLauncherPod(k8sv1.ContainerStatus{Name: "compute"}),
+			newLauncherPod(k8sv1.ContainerStatus{Name: "compute", State: oomKilled("containerd://compute")}),
+		)
+		Expect(getCounterValue()).To(Equal(1.0))
+	})
+
+	It("should count an OOM kill of a restarted container once", func() {
+		healthy := newLauncherPod(k8sv1.ContainerStatus{Name: "hook-sidecar-0"})
+		killed := newLauncherPod(k8sv1.ContainerStatus{Name: "hook-sidecar-0", State: oomKilled("containerd://sidecar-1")})
+		restarted := newLauncherPod(k8sv1.ContainerStatus{
+			Name:                 "hook-sidecar-0",
+			RestartCount:         1,
+			LastTerminationState: oomKilled("containerd://sidecar-1"),
+		})
+
+		tracker.update(healthy, killed)
+		tracker.update(killed, restarted)
+		tracker.update(restarted, restarted)
+		// A stale old object after a relist must not count the same kill again
+		tracker.update(healthy, restarted)
+		Expect(getCounterValue()).To(Equal(1.0))
+
+		restartedAgain := newLauncherPod(k8sv1.ContainerStatus{
+			Name:                 "hook-sidecar-0",
+			RestartCount:         2,
+			LastTerminationState: oomKilled("containerd://sidecar-2"),
+		})
+		tracker.update(restarted, restartedAgain)
+		Expect(getCounterValue()).To(Equal(2.0))
+	})
+
+	It("should not count OOM kills that were already present before the update", func() {
+		killed := newLauncherPod(k8sv1.ContainerStatus{Name: "compute", State: oomKilled("containerd://compute")})
+		tracker.update(killed, killed)
+		Expect(getCounterValue()).To(BeZero())
+	})
+
+	It("should not count other terminations", func() {
+		tracker.update(
+			newLauncherPod(k8sv1.ContainerStatus{Name: "compute"}),
+			newLauncherPod(k8sv1.ContainerStatus{Name: "compute", State: k8sv1.ContainerState{
+				Terminated: &k8sv1.ContainerStateTerminated{Reason: "Error"},
+			}}),
+		)
+		Expect(getCounterValue()).To(BeZero())
+	})
+
+	It("should ignore pods that are not virt-launcher pods", func() {
+		oldPod := newLauncherPod(k8sv1.ContainerStatus{Name: "hotplug-disk"})
+		newPod := newLauncherPod(k8sv1.ContainerStatus{Name: "hotplug-disk", State: oomKilled("containerd://hotplug")})
+		newPod.Labels[v1.AppLabel] = "hotplug-disk"
+
+		tracker.update(oldPod, newPod)
+		Expect(getCounterValue()).To(BeZero())
+	})
+
+	It("should forget a deleted VMI", func() {
+		killed := newLauncherPod(k8sv1.ContainerStatus{Name: "compute", State: oomKilled("containerd://compute")})
+		tracker.update(newLauncherPod(k8sv1.ContainerStatus{Name: "compute"}), killed)
+		Expect(tracker.seen).To(HaveLen(1))
+
+		tracker.forget(&v1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test-ns", Name: "test-vmi"},
+		})
+		Expect(tracker.seen).To(BeEmpty())
+
+		metrics := make(chan prometheus.Metric, 1)
+		vmiOOMEvents.Collect(metrics)
+		Expect(metrics).To(BeEmpty())
+	})
+})
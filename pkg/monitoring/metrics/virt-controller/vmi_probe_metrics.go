@@ -0,0 +1,67 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtcontroller
+
+import (
+	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+)
+
+var (
+	vmiProbeMetrics = []operatormetrics.Metric{
+		vmiProbeFailures,
+	}
+
+	vmiProbeFailures = operatormetrics.NewCounter(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_probe_failures_total",
+			Help: "Total number of times a running VirtualMachineInstance with a readiness probe stopped being ready.",
+		},
+	)
+)
+
+func AddVMIProbeHandlers(informer cache.SharedIndexInformer) error {
+	_, err := informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(oldVMI, newVMI interface{}) {
+			updateVMIProbeFailures(oldVMI.(*v1.VirtualMachineInstance), newVMI.(*v1.VirtualMachineInstance))
+		},
+	})
+	return err
+}
+
+// updateVMIProbeFailures counts a readiness probe failure when a running VMI loses its Ready
+// condition. Liveness probe failures are not counted, the launcher pod is killed and the VMI
+// fails in the same way as for any other launcher termination.
+func updateVMIProbeFailures(oldVMI, newVMI *v1.VirtualMachineInstance) {
+	if newVMI.Spec.ReadinessProbe == nil || newVMI.Status.Phase != v1.Running || newVMI.DeletionTimestamp != nil {
+		return
+	}
+
+	conditionManager := controller.NewVirtualMachineInstanceConditionManager()
+	if conditionManager.HasConditionWithStatus(oldVMI, v1.VirtualMachineInstanceReady, k8sv1.ConditionTrue) &&
+		!conditionManager.HasConditionWithStatus(newVMI, v1.VirtualMachineInstanceReady, k8sv1.ConditionTrue) {
+		vmiProbeFailures.Inc()
+	}
+}
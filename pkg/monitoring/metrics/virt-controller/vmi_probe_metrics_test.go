@@ -0,0 +1,85 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtcontroller
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	ioprometheusclient "github.com/prometheus/client_model/go"
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+)
+
+var _ = Describe("VMI probe failures counter", func() {
+	var initialValue float64
+
+	getCounterValue := func() float64 {
+		metric := &ioprometheusclient.Metric{}
+		Expect(vmiProbeFailures.Write(metric)).To(Succeed())
+		return metric.GetCounter().GetValue()
+	}
+
+	BeforeEach(func() {
+		initialValue = getCounterValue()
+	})
+
+	newVMI := func(readinessProbe *v1.Probe, phase v1.VirtualMachineInstancePhase, ready k8sv1.ConditionStatus) *v1.VirtualMachineInstance {
+		return &v1.VirtualMachineInstance{
+			Spec: v1.VirtualMachineInstanceSpec{
+				ReadinessProbe: readinessProbe,
+			},
+			Status: v1.VirtualMachineInstanceStatus{
+				Phase: phase,
+				Conditions: []v1.VirtualMachineInstanceCondition{
+					{Type: v1.VirtualMachineInstanceReady, Status: ready},
+				},
+			},
+		}
+	}
+
+	DescribeTable("should count readiness probe failures", func(oldVMI, newVMI *v1.VirtualMachineInstance, expected float64) {
+		updateVMIProbeFailures(oldVMI, newVMI)
+		Expect(getCounterValue() - initialValue).To(Equal(expected))
+	},
+		Entry("when a running VMI with a readiness probe stops being ready",
+			newVMI(&v1.Probe{}, v1.Running, k8sv1.ConditionTrue), newVMI(&v1.Probe{}, v1.Running, k8sv1.ConditionFalse), 1.0),
+		Entry("not when the VMI stays ready",
+			newVMI(&v1.Probe{}, v1.Running, k8sv1.ConditionTrue), newVMI(&v1.Probe{}, v1.Running, k8sv1.ConditionTrue), 0.0),
+		Entry("not when the VMI was not ready before",
+			newVMI(&v1.Probe{}, v1.Running, k8sv1.ConditionFalse), newVMI(&v1.Probe{}, v1.Running, k8sv1.ConditionFalse), 0.0),
+		Entry("not when the VMI has no readiness probe",
+			newVMI(nil, v1.Running, k8sv1.ConditionTrue), newVMI(nil, v1.Running, k8sv1.ConditionFalse), 0.0),
+		Entry("not when the VMI is no longer running",
+			newVMI(&v1.Probe{}, v1.Running, k8sv1.ConditionTrue), newVMI(&v1.Probe{}, v1.Succeeded, k8sv1.ConditionFalse), 0.0),
+	)
+
+	It("should not count a VMI being deleted", func() {
+		deletedVMI := newVMI(&v1.Probe{}, v1.Running, k8sv1.ConditionFalse)
+		deletedVMI.DeletionTimestamp = pointer.P(metav1.Now())
+
+		updateVMIProbeFailures(newVMI(&v1.Probe{}, v1.Running, k8sv1.ConditionTrue), deletedVMI)
+		Expect(getCounterValue()).To(Equal(initialValue))
+	})
+})
@@ -0,0 +1,210 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ */
+
+package virtcontroller
+
+import (
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/monitoring/metrics/common/catalog"
+)
+
+var (
+	vmiResourceHotplugMetrics = []operatormetrics.Metric{
+		vmiCPUHotplugRequests,
+		vmiCPUHotplugDuration,
+		vmiMemoryHotplugRequests,
+		vmiMemoryHotplugDuration,
+	}
+
+	vmiCPUHotplugRequests = catalog.NewCounterVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_cpu_hotplug_total",
+			Help: "Total number of in-place CPU hotplug operations of VirtualMachineInstances, by status.",
+		},
+		[]string{"status"},
+	)
+
+	vmiCPUHotplugDuration = operatormetrics.NewHistogram(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_cpu_hotplug_duration_seconds",
+			Help: "Histogram of the time from a CPU hotplug being requested on the VirtualMachineInstance " +
+				"until it completes or fails, in seconds.",
+		},
+		prometheus.HistogramOpts{
+			Buckets: PhaseTransitionTimeBuckets(),
+		},
+	)
+
+	vmiMemoryHotplugRequests = catalog.NewCounterVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_memory_hotplug_total",
+			Help: "Total number of in-place memory hotplug operations of VirtualMachineInstances, by status.",
+		},
+		[]string{"status"},
+	)
+
+	vmiMemoryHotplugDuration = operatormetrics.NewHistogram(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_memory_hotplug_duration_seconds",
+			Help: "Histogram of the time from a memory hotplug being requested on the VirtualMachineInstance " +
+				"until it completes or fails, in seconds.",
+		},
+		prometheus.HistogramOpts{
+			Buckets: PhaseTransitionTimeBuckets(),
+		},
+	)
+
+	cpuHotplugSource = resourceHotplugSource{
+		inProgress: isCPUHotplugInProgress,
+		failed:     isCPUHotplugRolledBack,
+		applied:    isCPUHotplugApplied,
+		requests:   vmiCPUHotplugRequests,
+		duration:   vmiCPUHotplugDuration,
+	}
+
+	memoryHotplugSource = resourceHotplugSource{
+		inProgress: isMemoryHotplugInProgress,
+		failed:     isMemoryHotplugFailed,
+		applied:    isMemoryHotplugApplied,
+		requests:   vmiMemoryHotplugRequests,
+		duration:   vmiMemoryHotplugDuration,
+	}
+
+	cpuHotplugTracker    = newResourceHotplugTracker(time.Now, cpuHotplugSource)
+	memoryHotplugTracker = newResourceHotplugTracker(time.Now, memoryHotplugSource)
+)
+
+const (
+	resourceHotplugSucceeded = "succeeded"
+	resourceHotplugFailed    = "failed"
+)
+
+// resourceHotplugSource describes an in-place resource hotplug for the resourceHotplugTracker.
+// inProgress reports whether the VMI has a pending hotplug, failed whether the hotplug was
+// reported as failed and applied whether the VMI status reflects the requested resources.
+type resourceHotplugSource struct {
+	inProgress func(vmi *v1.VirtualMachineInstance) bool
+	failed     func(vmi *v1.VirtualMachineInstance) bool
+	applied    func(vmi *v1.VirtualMachineInstance) bool
+	requests   *operatormetrics.CounterVec
+	duration   prometheus.Observer
+}
+
+// resourceHotplugTracker remembers when a CPU or memory hotplug was first seen in progress on
+// a VMI, so its duration can be observed once the hotplug condition is cleared.
+type resourceHotplugTracker struct {
+	lock    sync.Mutex
+	now     func() time.Time
+	source  resourceHotplugSource
+	started map[types.NamespacedName]time.Time
+}
+
+func newResourceHotplugTracker(now func() time.Time, source resourceHotplugSource) *resourceHotplugTracker {
+	return &resourceHotplugTracker{
+		now:     now,
+		source:  source,
+		started: map[types.NamespacedName]time.Time{},
+	}
+}
+
+func (t *resourceHotplugTracker) update(oldVMI, newVMI *v1.VirtualMachineInstance) {
+	key := types.NamespacedName{Namespace: newVMI.Namespace, Name: newVMI.Name}
+	now := t.now()
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if t.source.inProgress(newVMI) {
+		if _, exists := t.started[key]; !exists {
+			t.started[key] = now
+		}
+		return
+	}
+	if !t.source.inProgress(oldVMI) {
+		return
+	}
+
+	status := resourceHotplugSucceeded
+	if t.source.failed(newVMI) || !t.source.applied(newVMI) {
+		status = resourceHotplugFailed
+	}
+	t.source.requests.WithLabelValues(status).Inc()
+
+	if started, exists := t.started[key]; exists {
+		t.source.duration.Observe(now.Sub(started).Seconds())
+		delete(t.started, key)
+	}
+}
+
+func (t *resourceHotplugTracker) forget(vmi *v1.VirtualMachineInstance) {
+	key := types.NamespacedName{Namespace: vmi.Namespace, Name: vmi.Name}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	delete(t.started, key)
+}
+
+func isCPUHotplugInProgress(vmi *v1.VirtualMachineInstance) bool {
+	return controller.NewVirtualMachineInstanceConditionManager().
+		HasCondition(vmi, v1.VirtualMachineInstanceVCPUChange)
+}
+
+func isCPUHotplugRolledBack(vmi *v1.VirtualMachineInstance) bool {
+	return controller.NewVirtualMachineInstanceConditionManager().
+		HasConditionWithStatus(vmi, v1.VirtualMachineInstanceHotplugRolledBack, k8sv1.ConditionTrue)
+}
+
+func isCPUHotplugApplied(vmi *v1.VirtualMachineInstance) bool {
+	current := vmi.Status.CurrentCPUTopology
+	requested := vmi.Spec.Domain.CPU
+	if current == nil || requested == nil {
+		return false
+	}
+	return current.Sockets == requested.Sockets &&
+		current.Cores == requested.Cores &&
+		current.Threads == requested.Threads
+}
+
+func isMemoryHotplugInProgress(vmi *v1.VirtualMachineInstance) bool {
+	return controller.NewVirtualMachineInstanceConditionManager().
+		HasConditionWithStatus(vmi, v1.VirtualMachineInstanceMemoryChange, k8sv1.ConditionTrue)
+}
+
+func isMemoryHotplugFailed(vmi *v1.VirtualMachineInstance) bool {
+	return controller.NewVirtualMachineInstanceConditionManager().
+		HasConditionWithStatus(vmi, v1.VirtualMachineInstanceMemoryChange, k8sv1.ConditionFalse)
+}
+
+func isMemoryHotplugApplied(vmi *v1.VirtualMachineInstance) bool {
+	if vmi.Status.Memory == nil || vmi.Status.Memory.GuestRequested == nil ||
+		vmi.Spec.Domain.Memory == nil || vmi.Spec.Domain.Memory.Guest == nil {
+		return false
+	}
+	return vmi.Status.Memory.GuestRequested.Equal(*vmi.Spec.Domain.Memory.Guest)
+}
@@ -0,0 +1,194 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtcontroller
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	ioprometheusclient "github.com/prometheus/client_model/go"
+	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+var _ = Describe("VMI CPU and memory hotplug metrics", func() {
+	var now time.Time
+
+	BeforeEach(func() {
+		now = time.Now()
+		vmiCPUHotplugRequests.Reset()
+		vmiMemoryHotplugRequests.Reset()
+	})
+
+	getHistogram := func(histogram *operatormetrics.Histogram) *ioprometheusclient.Histogram {
+		metric := &ioprometheusclient.Metric{}
+		Expect(histogram.Write(metric)).To(Succeed())
+		return metric.GetHistogram()
+	}
+
+	getCounterValue := func(counter *operatormetrics.CounterVec, status string) float64 {
+		metric := &ioprometheusclient.Metric{}
+		Expect(counter.WithLabelValues(status).Write(metric)).To(Succeed())
+		return metric.GetCounter().GetValue()
+	}
+
+	newVMI := func(conditions ...v1.VirtualMachineInstanceCondition) *v1.VirtualMachineInstance {
+		return &v1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "test-ns",
+				Name:      "test-vmi",
+			},
+			Status: v1.VirtualMachineInstanceStatus{
+				Conditions: conditions,
+			},
+		}
+	}
+
+	Context("CPU hotplug", func() {
+		var tracker *resourceHotplugTracker
+
+		BeforeEach(func() {
+			tracker = newResourceHotplugTracker(func() time.Time { return now }, cpuHotplugSource)
+		})
+
+		vcpuChange := v1.VirtualMachineInstanceCondition{
+			Type:   v1.VirtualMachineInstanceVCPUChange,
+			Status: k8sv1.ConditionTrue,
+		}
+
+		newCPUVMI := func(sockets, currentSockets uint32, conditions ...v1.VirtualMachineInstanceCondition) *v1.VirtualMachineInstance {
+			vmi := newVMI(conditions...)
+			vmi.Spec.Domain.CPU = &v1.CPU{Sockets: sockets, Cores: 1, Threads: 1}
+			vmi.Status.CurrentCPUTopology = &v1.CPUTopology{Sockets: currentSockets, Cores: 1, Threads: 1}
+			return vmi
+		}
+
+		It("should observe the time until the CPU topology is applied", func() {
+			before := getHistogram(vmiCPUHotplugDuration)
+
+			tracker.update(newCPUVMI(2, 2), newCPUVMI(4, 2, vcpuChange))
+			now = now.Add(3 * time.Second)
+			tracker.update(newCPUVMI(4, 2, vcpuChange), newCPUVMI(4, 4))
+
+			after := getHistogram(vmiCPUHotplugDuration)
+			Expect(after.GetSampleCount() - before.GetSampleCount()).To(Equal(uint64(1)))
+			Expect(after.GetSampleSum() - before.GetSampleSum()).To(BeNumerically("~", 3.0))
+			Expect(getCounterValue(vmiCPUHotplugRequests, resourceHotplugSucceeded)).To(Equal(1.0))
+			Expect(getCounterValue(vmiCPUHotplugRequests, resourceHotplugFailed)).To(BeZero())
+			Expect(tracker.started).To(BeEmpty())
+		})
+
+		It("should count a hotplug that did not change the CPU topology as failed", func() {
+			tracker.update(newCPUVMI(2, 2), newCPUVMI(4, 2, vcpuChange))
+			tracker.update(newCPUVMI(4, 2, vcpuChange), newCPUVMI(4, 2))
+
+			Expect(getCounterValue(vmiCPUHotplugRequests, resourceHotplugSucceeded)).To(BeZero())
+			Expect(getCounterValue(vmiCPUHotplugRequests, resourceHotplugFailed)).To(Equal(1.0))
+		})
+
+		It("should count a rolled back hotplug as failed", func() {
+			rolledBack := v1.VirtualMachineInstanceCondition{
+				Type:   v1.VirtualMachineInstanceHotplugRolledBack,
+				Status: k8sv1.ConditionTrue,
+				Reason: v1.VirtualMachineInstanceReasonHotplugGuestRejected,
+			}
+			tracker.update(newCPUVMI(2, 2), newCPUVMI(4, 2, vcpuChange))
+			tracker.update(newCPUVMI(4, 2, vcpuChange), newCPUVMI(4, 4, rolledBack))
+
+			Expect(getCounterValue(vmiCPUHotplugRequests, resourceHotplugSucceeded)).To(BeZero())
+			Expect(getCounterValue(vmiCPUHotplugRequests, resourceHotplugFailed)).To(Equal(1.0))
+		})
+
+		It("should forget a pending hotplug of a deleted VMI", func() {
+			tracker.update(newCPUVMI(2, 2), newCPUVMI(4, 2, vcpuChange))
+			Expect(tracker.started).To(HaveLen(1))
+
+			tracker.forget(newCPUVMI(4, 2))
+			Expect(tracker.started).To(BeEmpty())
+		})
+	})
+
+	Context("memory hotplug", func() {
+		var tracker *resourceHotplugTracker
+
+		BeforeEach(func() {
+			tracker = newResourceHotplugTracker(func() time.Time { return now }, memoryHotplugSource)
+		})
+
+		memoryChange := v1.VirtualMachineInstanceCondition{
+			Type:   v1.VirtualMachineInstanceMemoryChange,
+			Status: k8sv1.ConditionTrue,
+		}
+		memoryChangeFailed := v1.VirtualMachineInstanceCondition{
+			Type:   v1.VirtualMachineInstanceMemoryChange,
+			Status: k8sv1.ConditionFalse,
+			Reason: "Memory Hotplug Failed",
+		}
+
+		newMemoryVMI := func(guest, guestRequested string, conditions ...v1.VirtualMachineInstanceCondition) *v1.VirtualMachineInstance {
+			vmi := newVMI(conditions...)
+			guestQuantity := resource.MustParse(guest)
+			guestRequestedQuantity := resource.MustParse(guestRequested)
+			vmi.Spec.Domain.Memory = &v1.Memory{Guest: &guestQuantity}
+			vmi.Status.Memory = &v1.MemoryStatus{GuestRequested: &guestRequestedQuantity}
+			return vmi
+		}
+
+		It("should observe the time until the requested guest memory is applied", func() {
+			before := getHistogram(vmiMemoryHotplugDuration)
+
+			tracker.update(newMemoryVMI("1Gi", "1Gi"), newMemoryVMI("2Gi", "1Gi", memoryChange))
+			now = now.Add(5 * time.Second)
+			tracker.update(newMemoryVMI("2Gi", "1Gi", memoryChange), newMemoryVMI("2Gi", "2Gi"))
+
+			after := getHistogram(vmiMemoryHotplugDuration)
+			Expect(after.GetSampleCount() - before.GetSampleCount()).To(Equal(uint64(1)))
+			Expect(after.GetSampleSum() - before.GetSampleSum()).To(BeNumerically("~", 5.0))
+			Expect(getCounterValue(vmiMemoryHotplugRequests, resourceHotplugSucceeded)).To(Equal(1.0))
+			Expect(tracker.started).To(BeEmpty())
+		})
+
+		It("should count a hotplug reported as failed", func() {
+			before := getHistogram(vmiMemoryHotplugDuration)
+
+			tracker.update(newMemoryVMI("1Gi", "1Gi"), newMemoryVMI("2Gi", "1Gi", memoryChange))
+			now = now.Add(time.Second)
+			tracker.update(newMemoryVMI("2Gi", "1Gi", memoryChange), newMemoryVMI("2Gi", "1Gi", memoryChangeFailed))
+
+			after := getHistogram(vmiMemoryHotplugDuration)
+			Expect(after.GetSampleCount() - before.GetSampleCount()).To(Equal(uint64(1)))
+			Expect(getCounterValue(vmiMemoryHotplugRequests, resourceHotplugSucceeded)).To(BeZero())
+			Expect(getCounterValue(vmiMemoryHotplugRequests, resourceHotplugFailed)).To(Equal(1.0))
+		})
+
+		It("should not count updates without a pending hotplug", func() {
+			tracker.update(newMemoryVMI("1Gi", "1Gi"), newMemoryVMI("1Gi", "1Gi", memoryChangeFailed))
+
+			Expect(getCounterValue(vmiMemoryHotplugRequests, resourceHotplugSucceeded)).To(BeZero())
+			Expect(getCounterValue(vmiMemoryHotplugRequests, resourceHotplugFailed)).To(BeZero())
+		})
+	})
+})
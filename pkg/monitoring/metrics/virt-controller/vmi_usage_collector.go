@@ -0,0 +1,190 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtcontroller
+
+import (
+	"sync"
+	"time"
+
+	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
+	"k8s.io/apimachinery/pkg/types"
+
+	k6tv1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/monitoring/metrics/common/catalog"
+	"kubevirt.io/kubevirt/pkg/util/hardware"
+)
+
+var (
+	vmiUsageCollector = operatormetrics.Collector{
+		Metrics: []operatormetrics.Metric{
+			vmiRunningSeconds,
+			vmiCPUCoreSeconds,
+			vmiMemoryByteSeconds,
+		},
+		CollectCallback: whenCachesSynced(vmiUsageCollectorCallback),
+	}
+
+	vmiRunningSeconds = catalog.NewCounterVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_running_seconds_total",
+			Help: "The total time the VirtualMachineInstance has been running, in seconds.",
+		},
+		[]string{"namespace", "name"},
+	)
+
+	vmiCPUCoreSeconds = catalog.NewCounterVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_cpu_core_seconds_total",
+			Help: "The vCPUs of the VirtualMachineInstance integrated over the time it has been running, in seconds.",
+		},
+		[]string{"namespace", "name"},
+	)
+
+	vmiMemoryByteSeconds = catalog.NewCounterVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_memory_byte_seconds_total",
+			Help: "The guest memory of the VirtualMachineInstance in bytes integrated over the time it has been running, in seconds.",
+		},
+		[]string{"namespace", "name"},
+	)
+
+	usageTracker = newVMIUsageTracker()
+)
+
+// vmiUsage is the usage accumulated for a VMI up to lastObserved.
+type vmiUsage struct {
+	lastObserved      time.Time
+	runningSeconds    float64
+	cpuCoreSeconds    float64
+	memoryByteSeconds float64
+}
+
+// vmiUsageTracker integrates the usage of each VMI between scrapes, using the resources the VMI has
+// at each scrape, so CPU or memory hotplug only changes the usage from then on. The usage of a VMI
+// that is first seen is estimated with its current resources since it entered the Running phase.
+// The usage is kept across restarts of a VMI with the same name and dropped once it is deleted.
+type vmiUsageTracker struct {
+	lock  sync.Mutex
+	usage map[types.NamespacedName]*vmiUsage
+}
+
+func newVMIUsageTracker() *vmiUsageTracker {
+	return &vmiUsageTracker{
+		usage: map[types.NamespacedName]*vmiUsage{},
+	}
+}
+
+func vmiUsageCollectorCallback() []operatormetrics.CollectorResult {
+	cachedObjs := stores.VMI.List()
+	vmis := make([]*k6tv1.VirtualMachineInstance, len(cachedObjs))
+	for i, obj := range cachedObjs {
+		vmis[i] = obj.(*k6tv1.VirtualMachineInstance)
+	}
+
+	return usageTracker.report(vmis, time.Now())
+}
+
+// report accumulates the usage of the VMIs up to now. Finished VMIs keep reporting their final
+// values until deleted.
+func (t *vmiUsageTracker) report(vmis []*k6tv1.VirtualMachineInstance, now time.Time) []operatormetrics.CollectorResult {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	var crs []operatormetrics.CollectorResult
+	seen := map[types.NamespacedName]struct{}{}
+	for _, vmi := range vmis {
+		key := types.NamespacedName{Namespace: vmi.Namespace, Name: vmi.Name}
+		seen[key] = struct{}{}
+
+		usage := t.usage[key]
+		if start, end, ok := getVMIRunningInterval(vmi, now); ok {
+			if usage == nil {
+				usage = &vmiUsage{}
+				t.usage[key] = usage
+			}
+			usage.add(vmi, start, end)
+		}
+		if usage == nil {
+			continue
+		}
+
+		labels := []string{vmi.Namespace, vmi.Name}
+		crs = append(crs,
+			operatormetrics.CollectorResult{Metric: vmiRunningSeconds, Labels: labels, Value: usage.runningSeconds},
+			operatormetrics.CollectorResult{Metric: vmiCPUCoreSeconds, Labels: labels, Value: usage.cpuCoreSeconds},
+			operatormetrics.CollectorResult{Metric: vmiMemoryByteSeconds, Labels: labels, Value: usage.memoryByteSeconds},
+		)
+	}
+
+	for key := range t.usage {
+		if _, exists := seen[key]; !exists {
+			delete(t.usage, key)
+		}
+	}
+
+	return crs
+}
+
+// add accumulates the usage between the last observation, or start if it is later, and end with
+// the current resources of the VMI.
+func (u *vmiUsage) add(vmi *k6tv1.VirtualMachineInstance, start, end time.Time) {
+	if u.lastObserved.After(start) {
+		start = u.lastObserved
+	}
+	if !end.After(start) {
+		return
+	}
+
+	seconds := end.Sub(start).Seconds()
+	guestMemory, _ := getVMIMemoryResources(vmi)
+	u.runningSeconds += seconds
+	u.cpuCoreSeconds += float64(hardware.GetNumberOfVCPUs(vmi.Spec.Domain.CPU)) * seconds
+	u.memoryByteSeconds += guestMemory * seconds
+	u.lastObserved = end
+}
+
+// getVMIRunningInterval returns when the VMI entered the Running phase and now, or the end of
+// the VMI when it already finished.
+func getVMIRunningInterval(vmi *k6tv1.VirtualMachineInstance, now time.Time) (start, end time.Time, ok bool) {
+	var runningSince, finishedAt time.Time
+	for _, transition := range vmi.Status.PhaseTransitionTimestamps {
+		switch transition.Phase {
+		case k6tv1.Running:
+			runningSince = transition.PhaseTransitionTimestamp.Time
+		case k6tv1.Succeeded, k6tv1.Failed:
+			finishedAt = transition.PhaseTransitionTimestamp.Time
+		}
+	}
+
+	if runningSince.IsZero() {
+		return time.Time{}, time.Time{}, false
+	}
+
+	end = now
+	if vmi.IsFinal() {
+		if finishedAt.IsZero() {
+			return time.Time{}, time.Time{}, false
+		}
+		end = finishedAt
+	}
+
+	return runningSince, end, true
+}
@@ -0,0 +1,178 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtcontroller
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	k6tv1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+)
+
+var _ = Describe("VMI Usage Collector", func() {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	newVMI := func(phase k6tv1.VirtualMachineInstancePhase, transitions ...k6tv1.VirtualMachineInstancePhaseTransitionTimestamp) *k6tv1.VirtualMachineInstance {
+		return &k6tv1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test-ns", Name: "test-vmi"},
+			Spec: k6tv1.VirtualMachineInstanceSpec{
+				Domain: k6tv1.DomainSpec{
+					CPU:    &k6tv1.CPU{Cores: 2, Sockets: 1, Threads: 1},
+					Memory: &k6tv1.Memory{Guest: pointer.P(resource.MustParse("1Gi"))},
+				},
+			},
+			Status: k6tv1.VirtualMachineInstanceStatus{
+				Phase:                     phase,
+				PhaseTransitionTimestamps: transitions,
+			},
+		}
+	}
+
+	transition := func(phase k6tv1.VirtualMachineInstancePhase, ago time.Duration) k6tv1.VirtualMachineInstancePhaseTransitionTimestamp {
+		return k6tv1.VirtualMachineInstancePhaseTransitionTimestamp{
+			Phase:                    phase,
+			PhaseTransitionTimestamp: metav1.NewTime(now.Add(-ago)),
+		}
+	}
+
+	getValue := func(crs []operatormetrics.CollectorResult, metric operatormetrics.Metric) (float64, bool) {
+		for _, cr := range crs {
+			if cr.Metric == metric {
+				Expect(cr.Labels).To(Equal([]string{"test-ns", "test-vmi"}))
+				return cr.Value, true
+			}
+		}
+		return 0, false
+	}
+
+	It("should not report VMIs that never ran", func() {
+		crs := newVMIUsageTracker().report([]*k6tv1.VirtualMachineInstance{
+			newVMI(k6tv1.Scheduling, transition(k6tv1.Scheduling, time.Minute)),
+		}, now)
+		Expect(crs).To(BeEmpty())
+	})
+
+	It("should report the usage of a running VMI since it entered the Running phase", func() {
+		crs := newVMIUsageTracker().report([]*k6tv1.VirtualMachineInstance{
+			newVMI(k6tv1.Running,
+				transition(k6tv1.Scheduled, 2*time.Hour),
+				transition(k6tv1.Running, time.Hour),
+			),
+		}, now)
+
+		running, found := getValue(crs, vmiRunningSeconds)
+		Expect(found).To(BeTrue())
+		Expect(running).To(Equal(3600.0))
+
+		cpu, found := getValue(crs, vmiCPUCoreSeconds)
+		Expect(found).To(BeTrue())
+		Expect(cpu).To(Equal(7200.0))
+
+		memory, found := getValue(crs, vmiMemoryByteSeconds)
+		Expect(found).To(BeTrue())
+		Expect(memory).To(Equal(float64(1024*1024*1024) * 3600))
+	})
+
+	It("should keep reporting the final usage of a finished VMI", func() {
+		crs := newVMIUsageTracker().report([]*k6tv1.VirtualMachineInstance{
+			newVMI(k6tv1.Succeeded,
+				transition(k6tv1.Running, time.Hour),
+				transition(k6tv1.Succeeded, 30*time.Minute),
+			),
+		}, now)
+
+		running, found := getValue(crs, vmiRunningSeconds)
+		Expect(found).To(BeTrue())
+		Expect(running).To(Equal(1800.0))
+	})
+
+	It("should accumulate the usage with the resources of each interval", func() {
+		tracker := newVMIUsageTracker()
+		vmi := newVMI(k6tv1.Running, transition(k6tv1.Running, time.Hour))
+
+		var previousCPU float64
+		scrape := func(at time.Time, expectedRunning, expectedCPU float64) {
+			crs := tracker.report([]*k6tv1.VirtualMachineInstance{vmi}, at)
+			running, found := getValue(crs, vmiRunningSeconds)
+			Expect(found).To(BeTrue())
+			Expect(running).To(Equal(expectedRunning))
+			cpu, found := getValue(crs, vmiCPUCoreSeconds)
+			Expect(found).To(BeTrue())
+			Expect(cpu).To(Equal(expectedCPU))
+			Expect(cpu).To(BeNumerically(">=", previousCPU))
+			previousCPU = cpu
+		}
+
+		scrape(now, 3600, 2*3600)
+
+		// CPU hotplug only adds to the usage from now on
+		vmi.Spec.Domain.CPU.Sockets = 2
+		scrape(now.Add(30*time.Minute), 5400, 2*3600+4*1800)
+
+		// CPU hot-unplug does not decrease the usage
+		vmi.Spec.Domain.CPU.Sockets = 1
+		vmi.Spec.Domain.CPU.Cores = 1
+		scrape(now.Add(time.Hour), 7200, 2*3600+4*1800+1800)
+
+		// Scraping again at the same time adds nothing
+		scrape(now.Add(time.Hour), 7200, 2*3600+4*1800+1800)
+	})
+
+	It("should keep accumulating the usage when the VMI is restarted", func() {
+		tracker := newVMIUsageTracker()
+		tracker.report([]*k6tv1.VirtualMachineInstance{
+			newVMI(k6tv1.Succeeded,
+				transition(k6tv1.Running, 2*time.Hour),
+				transition(k6tv1.Succeeded, time.Hour),
+			),
+		}, now)
+
+		crs := tracker.report([]*k6tv1.VirtualMachineInstance{
+			newVMI(k6tv1.Running, transition(k6tv1.Running, 30*time.Minute)),
+		}, now)
+		running, found := getValue(crs, vmiRunningSeconds)
+		Expect(found).To(BeTrue())
+		Expect(running).To(Equal(5400.0))
+	})
+
+	It("should forget the usage of deleted VMIs", func() {
+		tracker := newVMIUsageTracker()
+		tracker.report([]*k6tv1.VirtualMachineInstance{
+			newVMI(k6tv1.Running, transition(k6tv1.Running, time.Hour)),
+		}, now)
+		Expect(tracker.report(nil, now)).To(BeEmpty())
+		Expect(tracker.usage).To(BeEmpty())
+	})
+
+	It("should not report a finished VMI without a final transition timestamp", func() {
+		crs := newVMIUsageTracker().report([]*k6tv1.VirtualMachineInstance{
+			newVMI(k6tv1.Failed, transition(k6tv1.Running, time.Hour)),
+		}, now)
+		Expect(crs).To(BeEmpty())
+	})
+})
@@ -20,8 +20,11 @@
 package virtcontroller
 
 import (
+	"encoding/json"
+	"slices"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
 	k8sv1 "k8s.io/api/core/v1"
@@ -34,8 +37,13 @@ import (
 	k6tv1 "kubevirt.io/api/core/v1"
 
 	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/hooks"
 	"kubevirt.io/kubevirt/pkg/hypervisor"
+	"kubevirt.io/kubevirt/pkg/monitoring/metrics/common/catalog"
+	"kubevirt.io/kubevirt/pkg/network/multiqueue"
 	netresources "kubevirt.io/kubevirt/pkg/network/resources"
+	backendstorage "kubevirt.io/kubevirt/pkg/storage/backend-storage"
+	"kubevirt.io/kubevirt/pkg/util/hardware"
 	"kubevirt.io/kubevirt/pkg/util/migrations"
 	"kubevirt.io/kubevirt/pkg/virt-controller/services"
 )
@@ -43,7 +51,10 @@ import (
 const (
 	none      = "" // Empty values will be ignored by operator-observability and label will not be created
 	other     = "<other>"
-	modelNone = "<none>"
+	custom    = "custom"
+	valueNone = "<none>"
+
+	mebibyte = 1024 * 1024
 
 	annotationPrefix        = "vm.kubevirt.io/"
 	instancetypeVendorLabel = "instancetype.kubevirt.io/vendor"
@@ -65,11 +76,46 @@ var (
 			vmiVnicInfo,
 			vmiLauncherMemoryOverhead,
 			vmiEphemeralHotplugVolume,
+			vmiLauncherImage,
+			vmiPriorityClass,
+			vmiCustomHostname,
+			vmiTerminationGracePeriod,
+			vmiReady,
+			vmiLauncherOverheadClass,
+			vmiMigrationPolicy,
+			vmiRealtime,
+			vmiDNSPolicy,
+			vmiInstancetype,
+			vmiDesktopDevices,
+			vmiGPUCount,
+			vmiMemoryBalloon,
+			vmiNetworkQueueCount,
+			vmiMemoryLimitRequestGap,
+			vmiSSHKeySource,
+			vmiFirmwareFeatures,
+			vmiWatchdog,
+			vmiLauncherCPUOvercommit,
+			vmiSecurityProfile,
+			vmiDiskErrorPolicyCount,
+			vmiTolerationsCount,
+			vmiAge,
+			vmiSidecarCount,
+			vmiNodeSelectorCount,
+			vmiEphemeralHotplugVolumeCount,
+			vmiEphemeralHotplugVolumeSize,
+			vmiBackendStorage,
+			vmiPinnedVCPUCount,
+			vmiLauncherCPURequest,
+			vmiGPUDisplayEnabled,
+			vmiDiskDedicatedIOThreadCount,
+			vmiMemoryOvercommitFactor,
+			vmiMigrationDuration,
+			vmiMemoryDumpDuration,
 		},
-		CollectCallback: vmiStatsCollectorCallback,
+		CollectCallback: whenCachesSynced(vmiStatsCollectorCallback),
 	}
 
-	vmiInfo = operatormetrics.NewGaugeVec(
+	vmiInfo = catalog.NewGaugeVec(
 		operatormetrics.MetricOpts{
 			Name: "kubevirt_vmi_info",
 			Help: "Information about VirtualMachineInstances.",
@@ -90,7 +136,7 @@ var (
 		},
 	)
 
-	vmiEvictionBlocker = operatormetrics.NewGaugeVec(
+	vmiEvictionBlocker = catalog.NewGaugeVec(
 		operatormetrics.MetricOpts{
 			Name: "kubevirt_vmi_non_evictable",
 			Help: "Indication for a VirtualMachine that its eviction strategy is set to Live Migration but is not migratable.",
@@ -98,7 +144,7 @@ var (
 		[]string{"node", "namespace", "name"},
 	)
 
-	vmiAddresses = operatormetrics.NewGaugeVec(
+	vmiAddresses = catalog.NewGaugeVec(
 		operatormetrics.MetricOpts{
 			Name: "kubevirt_vmi_status_addresses",
 			Help: "The addresses of a VirtualMachineInstance. This metric provides the address of an available network " +
@@ -108,7 +154,7 @@ var (
 		[]string{"node", "namespace", "name", "vnic_name", "interface_name", "address", "type"},
 	)
 
-	vmiMigrationStartTime = operatormetrics.NewGaugeVec(
+	vmiMigrationStartTime = catalog.NewGaugeVec(
 		operatormetrics.MetricOpts{
 			Name: "kubevirt_vmi_migration_start_time_seconds",
 			Help: "The time at which the migration started.",
@@ -116,7 +162,7 @@ var (
 		[]string{"node", "namespace", "name", "migration_name"},
 	)
 
-	vmiMigrationEndTime = operatormetrics.NewGaugeVec(
+	vmiMigrationEndTime = catalog.NewGaugeVec(
 		operatormetrics.MetricOpts{
 			Name: "kubevirt_vmi_migration_end_time_seconds",
 			Help: "The time at which the migration ended.",
@@ -124,7 +170,7 @@ var (
 		[]string{"node", "namespace", "name", "migration_name", "status"},
 	)
 
-	vmiVnicInfo = operatormetrics.NewGaugeVec(
+	vmiVnicInfo = catalog.NewGaugeVec(
 		operatormetrics.MetricOpts{
 			Name: "kubevirt_vmi_vnic_info",
 			Help: "Details of VirtualMachineInstance (VMI) vNIC interfaces, such as vNIC name, binding type, " +
@@ -133,7 +179,7 @@ var (
 		[]string{"name", "namespace", "vnic_name", "binding_type", "network", "binding_name", "model"},
 	)
 
-	vmiLauncherMemoryOverhead = operatormetrics.NewGaugeVec(
+	vmiLauncherMemoryOverhead = catalog.NewGaugeVec(
 		operatormetrics.MetricOpts{
 			Name: "kubevirt_vmi_launcher_memory_overhead_bytes",
 			Help: "Estimation of the memory amount required for virt-launcher's infrastructure components (e.g. libvirt, QEMU).",
@@ -141,13 +187,325 @@ var (
 		[]string{"namespace", "name"},
 	)
 
-	vmiEphemeralHotplugVolume = operatormetrics.NewGaugeVec(
-		operatormetrics.MetricOpts{
+	vmiEphemeralHotplugVolume = catalog.NewGaugeVec(
+		catalog.Opts(operatormetrics.MetricOpts{
 			Name: "kubevirt_vmi_contains_ephemeral_hotplug_volume",
 			Help: "Reported only for VMIs that contain an ephemeral hotplug volume.",
 		},
+			catalog.WithStabilityLevel(catalog.Alpha),
+			catalog.WithLabelDescription("volume_name", "Name of the ephemeral hotplug volume in the VMI spec."),
+		),
 		[]string{"namespace", "name", "volume_name"},
 	)
+
+	vmiLauncherImage = catalog.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_launcher_image",
+			Help: "The virt-launcher container image currently active for the VirtualMachineInstance.",
+		},
+		[]string{"namespace", "name", "image"},
+	)
+
+	vmiPriorityClass = catalog.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_priority_class",
+			Help: "The priority class of the VirtualMachineInstance. Set to '<none>' when no priority class is configured.",
+		},
+		[]string{"namespace", "name", "priority_class"},
+	)
+
+	vmiCustomHostname = catalog.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_custom_hostname",
+			Help: "Reported only for VirtualMachineInstances that set a custom hostname or subdomain.",
+		},
+		[]string{"namespace", "name"},
+	)
+
+	vmiTerminationGracePeriod = catalog.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_termination_grace_period_seconds",
+			Help: "The grace period in seconds given to the VirtualMachineInstance guest to shut down gracefully.",
+		},
+		[]string{"namespace", "name"},
+	)
+
+	vmiReady = catalog.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_ready",
+			Help: "Indication for a VirtualMachineInstance that its Ready condition is true (1) or not (0).",
+		},
+		[]string{"namespace", "name"},
+	)
+
+	vmiLauncherOverheadClass = catalog.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_launcher_overhead_class",
+			Help: "The size class ('<128Mi', '128-256Mi' or '>256Mi') of the estimated memory amount required for " +
+				"virt-launcher's infrastructure components, as reported by kubevirt_vmi_launcher_memory_overhead_bytes.",
+		},
+		[]string{"namespace", "name", "size_class"},
+	)
+
+	vmiMigrationPolicy = catalog.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_migration_policy",
+			Help: "The migration policy applied to the last migration of the VirtualMachineInstance. " +
+				"Set to 'default' when no migration policy matched.",
+		},
+		[]string{"namespace", "name", "policy"},
+	)
+
+	vmiRealtime = catalog.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_realtime",
+			Help: "Reported only for VirtualMachineInstances with a realtime CPU configuration.",
+		},
+		[]string{"namespace", "name"},
+	)
+
+	vmiDNSPolicy = catalog.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_dns_policy",
+			Help: "The DNS policy of the VirtualMachineInstance. Set to 'ClusterFirst' when no DNS policy is configured.",
+		},
+		[]string{"namespace", "name", "policy"},
+	)
+
+	vmiInstancetype = catalog.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_instancetype",
+			Help: "The instance type and preference used by the VirtualMachineInstance. Set to 'custom' when none is " +
+				"referenced and to '<other>' for instance types and preferences not provided by a known vendor.",
+		},
+		[]string{"namespace", "name", "instancetype", "preference"},
+	)
+
+	vmiDesktopDevices = catalog.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_desktop_devices",
+			Help: "Reported for each desktop device type ('sound', 'video' or 'input') explicitly configured " +
+				"in the VirtualMachineInstance spec.",
+		},
+		[]string{"namespace", "name", "device"},
+	)
+
+	vmiGPUCount = catalog.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_gpu_count",
+			Help: "The number of GPU devices assigned to the VirtualMachineInstance, broken down by device resource name. " +
+				"GPUs requested through resource claims are reported as '<none>'.",
+		},
+		[]string{"namespace", "name", "gpu_name"},
+	)
+
+	vmiMemoryBalloon = catalog.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_memory_balloon",
+			Help: "Reported only for VirtualMachineInstances that have a memory balloon device attached.",
+		},
+		[]string{"namespace", "name"},
+	)
+
+	vmiNetworkQueueCount = catalog.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_network_queue_count",
+			Help: "The number of queues configured per virtio network interface of the VirtualMachineInstance, " +
+				"which is the same for all its interfaces. Set to 1 when network interface multi-queue is not enabled.",
+		},
+		[]string{"namespace", "name"},
+	)
+
+	vmiMemoryLimitRequestGap = catalog.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_memory_limit_request_gap_bytes",
+			Help: "The difference between the memory limit and the memory request of the VirtualMachineInstance. " +
+				"Set to 0 when no memory limit is configured.",
+		},
+		[]string{"namespace", "name"},
+	)
+
+	vmiSSHKeySource = catalog.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_ssh_key_source",
+			Help: "Reports how SSH public keys are provided to the VirtualMachineInstance. " +
+				"'secret' means keys are kept in sync with their secret by the guest agent, 'static' means keys " +
+				"are injected once at boot through cloud-init and 'none' means no SSH access credentials are configured.",
+		},
+		[]string{"namespace", "name", "source"},
+	)
+
+	vmiFirmwareFeatures = catalog.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_firmware_features",
+			Help: "Reported for each firmware feature ('smm', 'acpi' or 'hyperv') enabled " +
+				"in the VirtualMachineInstance spec.",
+		},
+		[]string{"namespace", "name", "feature"},
+	)
+
+	vmiWatchdog = catalog.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_watchdog",
+			Help: "Reported when a watchdog device is configured in the VirtualMachineInstance spec, " +
+				"labeled by the action taken when the watchdog expires.",
+		},
+		[]string{"namespace", "name", "action"},
+	)
+
+	vmiLauncherCPUOvercommit = catalog.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_launcher_cpu_overcommit",
+			Help: "The CPU allocation ratio applied when computing the virt-launcher CPU request of the VirtualMachineInstance. " +
+				"Set to 1 when the ratio does not apply, i.e. for dedicated CPUs or explicit CPU requests.",
+		},
+		[]string{"namespace", "name"},
+	)
+
+	vmiSecurityProfile = catalog.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_security_profile",
+			Help: "Reported for each hardening profile type ('seccomp', 'apparmor' or 'selinux') configured " +
+				"on the running virt-launcher pod of the VirtualMachineInstance.",
+		},
+		[]string{"namespace", "name", "profile_type"},
+	)
+
+	vmiDiskErrorPolicyCount = catalog.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_disk_error_policy_count",
+			Help: "The number of disks of the VirtualMachineInstance per I/O error policy " +
+				"('stop', 'report', 'ignore' or 'enospace'). Disks without an explicit policy are counted as 'stop'.",
+		},
+		[]string{"namespace", "name", "policy"},
+	)
+
+	vmiTolerationsCount = catalog.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_tolerations_count",
+			Help: "The number of tolerations configured in the VirtualMachineInstance spec.",
+		},
+		[]string{"namespace", "name"},
+	)
+
+	vmiAge = catalog.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_age_seconds",
+			Help: "The time elapsed since the VirtualMachineInstance was created, in seconds.",
+		},
+		[]string{"namespace", "name"},
+	)
+
+	vmiSidecarCount = catalog.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_sidecar_count",
+			Help: "The number of hook sidecars requested for the VirtualMachineInstance through the " +
+				"hooks.kubevirt.io/hookSidecars annotation. Each sidecar adds a container to the virt-launcher pod.",
+		},
+		[]string{"namespace", "name"},
+	)
+
+	vmiNodeSelectorCount = catalog.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_node_selector_count",
+			Help: "The number of nodeSelector entries configured in the VirtualMachineInstance spec.",
+		},
+		[]string{"namespace", "name"},
+	)
+
+	vmiEphemeralHotplugVolumeCount = catalog.NewGaugeVec(
+		catalog.Opts(operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_ephemeral_hotplug_volume_count",
+			Help: "The number of ephemeral hotplug volumes of the VirtualMachineInstance. " +
+				"Reported only for VMIs that contain an ephemeral hotplug volume.",
+		},
+			catalog.WithStabilityLevel(catalog.Alpha),
+		),
+		[]string{"namespace", "name"},
+	)
+
+	vmiEphemeralHotplugVolumeSize = catalog.NewGaugeVec(
+		catalog.Opts(operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_ephemeral_hotplug_volume_size_bytes",
+			Help: "The size of the PVC backing an ephemeral hotplug volume of the VirtualMachineInstance, " +
+				"by volume source and storage class.",
+		},
+			catalog.WithStabilityLevel(catalog.Alpha),
+			catalog.WithLabelDescription("volume_name", "Name of the ephemeral hotplug volume in the VMI spec."),
+			catalog.WithLabelDescription("source", "Source of the volume, pvc or datavolume."),
+			catalog.WithLabelDescription("storage_class", "Storage class of the PVC backing the volume."),
+		),
+		[]string{"namespace", "name", "volume_name", "source", "storage_class"},
+	)
+
+	vmiBackendStorage = catalog.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_backend_storage",
+			Help: "Reported when a backend storage PVC is provisioned for the persistent state " +
+				"(e.g. TPM or EFI) of the VirtualMachineInstance.",
+		},
+		[]string{"namespace", "name"},
+	)
+
+	vmiPinnedVCPUCount = catalog.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_pinned_vcpu_count",
+			Help: "The number of vCPUs of the VirtualMachineInstance pinned to dedicated host CPUs. " +
+				"Set to 0 when dedicatedCpuPlacement is not enabled.",
+		},
+		[]string{"namespace", "name"},
+	)
+
+	vmiLauncherCPURequest = catalog.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_launcher_cpu_request_millicores",
+			Help: "The total CPU request of the containers of the running virt-launcher pod of the VirtualMachineInstance, " +
+				"in millicores. Containers without a CPU request count as 0.",
+		},
+		[]string{"namespace", "name"},
+	)
+
+	vmiGPUDisplayEnabled = catalog.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_gpu_display_enabled",
+			Help: "Reported when at least one GPU of the VirtualMachineInstance has a vGPU-backed display enabled.",
+		},
+		[]string{"namespace", "name"},
+	)
+
+	vmiDiskDedicatedIOThreadCount = catalog.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_disk_dedicated_iothread_count",
+			Help: "The number of disks of the VirtualMachineInstance that are mapped to a dedicated IO thread.",
+		},
+		[]string{"namespace", "name"},
+	)
+
+	vmiMemoryOvercommitFactor = catalog.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_memory_overcommit_factor",
+			Help: "The ratio between the memory request plus the virt-launcher memory overhead and the memory limit " +
+				"of the VirtualMachineInstance. Only reported when a memory limit is configured.",
+		},
+		[]string{"namespace", "name"},
+	)
+
+	vmiMigrationDuration = catalog.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_migration_duration_seconds",
+			Help: "The time the last migration of the VirtualMachineInstance took, from its start until it ended.",
+		},
+		[]string{"node", "namespace", "name", "migration_name", "status"},
+	)
+
+	vmiMemoryDumpDuration = catalog.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_memory_dump_duration_seconds",
+			Help: "The time the memory dump of the VirtualMachineInstance took, or has taken so far while in progress, " +
+				"labeled by the PVC it is dumped to and its phase ('InProgress', 'Completed' or 'Failed').",
+		},
+		[]string{"namespace", "name", "claim_name", "phase"},
+	)
 )
 
 func vmiStatsCollectorCallback() []operatormetrics.CollectorResult {
@@ -168,40 +526,98 @@ func vmiStatsCollectorCallback() []operatormetrics.CollectorResult {
 
 func reportVmisStats(vmis []*k6tv1.VirtualMachineInstance) []operatormetrics.CollectorResult {
 	var crs []operatormetrics.CollectorResult
+	now := time.Now()
 
 	for _, vmi := range vmis {
 		crs = append(crs, collectVMIInfo(vmi), getEvictionBlocker(vmi))
 		crs = append(crs, collectVMIInterfacesInfo(vmi)...)
 		crs = append(crs, collectVMIMigrationTime(vmi)...)
 		crs = append(crs, CollectVmisVnicInfo(vmi)...)
-		crs = append(crs, collectVMILauncherMemoryOverhead(vmi))
+		memoryOverhead := collectVMILauncherMemoryOverhead(vmi)
+		crs = append(crs, memoryOverhead, collectVMILauncherOverheadClass(vmi, memoryOverhead.Value))
 		crs = append(crs, collectVMIEphemeralHotplug(vmi)...)
+		crs = append(crs, collectVMILauncherImage(vmi)...)
+		crs = append(crs, collectVMIPriorityClass(vmi))
+		crs = append(crs, collectVMICustomHostname(vmi)...)
+		crs = append(crs, collectVMITerminationGracePeriod(vmi))
+		crs = append(crs, collectVMIReady(vmi))
+		crs = append(crs, collectVMIMigrationPolicy(vmi)...)
+		crs = append(crs, collectVMIRealtime(vmi)...)
+		crs = append(crs, collectVMIDNSPolicy(vmi))
+		crs = append(crs, collectVMIInstancetype(vmi))
+		crs = append(crs, collectVMIDesktopDevices(vmi)...)
+		crs = append(crs, collectVMIGPUCount(vmi)...)
+		crs = append(crs, collectVMIMemoryBalloon(vmi)...)
+		crs = append(crs, collectVMINetworkQueueCount(vmi))
+		crs = append(crs, collectVMIMemoryLimitRequestGap(vmi))
+		crs = append(crs, collectVMISSHKeySource(vmi)...)
+		crs = append(crs, collectVMIFirmwareFeatures(vmi)...)
+		crs = append(crs, collectVMIWatchdog(vmi)...)
+		crs = append(crs, collectVMILauncherCPUOvercommit(vmi))
+		crs = append(crs, collectVMISecurityProfile(vmi)...)
+		crs = append(crs, collectVMIDiskErrorPolicyCount(vmi)...)
+		crs = append(crs, collectVMITolerationsCount(vmi))
+		crs = append(crs, collectVMIAge(vmi, now)...)
+		crs = append(crs, collectVMISidecarCount(vmi)...)
+		crs = append(crs, collectVMINodeSelectorCount(vmi))
+		crs = append(crs, collectVMIEphemeralHotplugVolumeCount(vmi)...)
+		crs = append(crs, collectVMIEphemeralHotplugVolumeSize(vmi)...)
+		crs = append(crs, collectVMIBackendStorage(vmi)...)
+		crs = append(crs, collectVMIPinnedVCPUCount(vmi))
+		crs = append(crs, collectVMILauncherCPURequest(vmi)...)
+		crs = append(crs, collectVMIGPUDisplayEnabled(vmi)...)
+		crs = append(crs, collectVMIDiskDedicatedIOThreadCount(vmi))
+		crs = append(crs, collectVMIMemoryOvercommitFactor(vmi)...)
+		crs = append(crs, collectVMIMigrationDuration(vmi)...)
+		crs = append(crs, collectVMIMemoryDumpDuration(vmi)...)
 	}
 
 	return crs
 }
 
 func collectVMILauncherMemoryOverhead(vmi *k6tv1.VirtualMachineInstance) operatormetrics.CollectorResult {
-	var memoryOverheadValue int64
+	// Metric values are float64, which represents every byte count up to
+	// 2^53 (8Pi) exactly, well above any realistic memory overhead.
+	return operatormetrics.CollectorResult{
+		Metric: vmiLauncherMemoryOverhead,
+		Labels: []string{vmi.Namespace, vmi.Name},
+		Value:  float64(getLauncherMemoryOverheadBytes(vmi)),
+	}
+}
+
+func getLauncherMemoryOverheadBytes(vmi *k6tv1.VirtualMachineInstance) int64 {
 	// Use the stored memory overhead from VMI status if available to ensure
 	// consistency with the actual pod configuration, especially after upgrades
 	// where the calculation logic might have changed
 	if vmi.Status.Memory != nil && vmi.Status.Memory.MemoryOverhead != nil {
-		memoryOverheadValue = vmi.Status.Memory.MemoryOverhead.Value()
-	} else {
-		// TODO: Remove this fallback once VmiMemoryOverheadReport feature gate is GA
-		// and we are sure that all VMIs include the MemoryOverhead status field
-		// Create the hypervisor resources calculator based on the cluster configuration, as the overhead calculation may differ between
-		// different hypervisors
-		launcherHypervisorResources := hypervisor.NewLauncherHypervisorResources(clusterConfig.GetHypervisor().Name)
-		memoryOverhead := services.CalculateMemoryOverhead(clusterConfig, netresources.MemoryCalculator{}, vmi, launcherHypervisorResources)
-		memoryOverheadValue = memoryOverhead.Value()
+		return vmi.Status.Memory.MemoryOverhead.Value()
 	}
 
+	// TODO: Remove this fallback once VmiMemoryOverheadReport feature gate is GA
+	// and we are sure that all VMIs include the MemoryOverhead status field
+	// Create the hypervisor resources calculator based on the cluster configuration, as the overhead calculation may differ between
+	// different hypervisors
+	launcherHypervisorResources := hypervisor.NewLauncherHypervisorResources(clusterConfig.GetHypervisor().Name)
+	memoryOverhead := services.CalculateMemoryOverhead(clusterConfig, netresources.MemoryCalculator{}, vmi, launcherHypervisorResources)
+	return memoryOverhead.Value()
+}
+
+func collectVMILauncherOverheadClass(vmi *k6tv1.VirtualMachineInstance, memoryOverheadBytes float64) operatormetrics.CollectorResult {
 	return operatormetrics.CollectorResult{
-		Metric: vmiLauncherMemoryOverhead,
-		Labels: []string{vmi.Namespace, vmi.Name},
-		Value:  float64(memoryOverheadValue),
+		Metric: vmiLauncherOverheadClass,
+		Labels: []string{vmi.Namespace, vmi.Name, getLauncherOverheadClass(memoryOverheadBytes)},
+		Value:  1.0,
+	}
+}
+
+func getLauncherOverheadClass(memoryOverheadBytes float64) string {
+	switch {
+	case memoryOverheadBytes < 128*mebibyte:
+		return "<128Mi"
+	case memoryOverheadBytes <= 256*mebibyte:
+		return "128-256Mi"
+	default:
+		return ">256Mi"
 	}
 }
 
@@ -284,25 +700,42 @@ func getVMIMachine(vmi *k6tv1.VirtualMachineInstance) (guestOSMachineType string
 }
 
 func getVMIPod(vmi *k6tv1.VirtualMachineInstance) string {
+	if pod := getVMIActivePod(vmi); pod != nil {
+		return pod.Name
+	}
+
+	return none
+}
+
+func getVMIActivePod(vmi *k6tv1.VirtualMachineInstance) *k8sv1.Pod {
+	for _, pod := range getVMIPods(vmi) {
+		if pod.Status.Phase == k8sv1.PodRunning && vmi.Status.NodeName == pod.Spec.NodeName {
+			return pod
+		}
+	}
+
+	return nil
+}
+
+func getVMIPods(vmi *k6tv1.VirtualMachineInstance) []*k8sv1.Pod {
 	objs, err := indexers.KVPod.ByIndex(cache.NamespaceIndex, vmi.Namespace)
 	if err != nil {
-		return none
+		return nil
 	}
 
+	var pods []*k8sv1.Pod
 	for _, obj := range objs {
 		pod, ok := obj.(*k8sv1.Pod)
 		if !ok {
 			continue
 		}
 
-		if pod.Labels["kubevirt.io/created-by"] == string(vmi.UID) && pod.Status.Phase == k8sv1.PodRunning {
-			if vmi.Status.NodeName == pod.Spec.NodeName {
-				return pod.Name
-			}
+		if pod.Labels["kubevirt.io/created-by"] == string(vmi.UID) {
+			pods = append(pods, pod)
 		}
 	}
 
-	return none
+	return pods
 }
 
 func getVMIInstancetype(vmi *k6tv1.VirtualMachineInstance) string {
@@ -484,7 +917,7 @@ func CollectVmisVnicInfo(vmi *k6tv1.VirtualMachineInstance) []operatormetrics.Co
 	networks := vmi.Spec.Networks
 
 	for _, iface := range interfaces {
-		model := modelNone
+		model := valueNone
 		if iface.Model != "" {
 			model = iface.Model
 		}
@@ -527,3 +960,747 @@ func collectVMIEphemeralHotplug(vmi *k6tv1.VirtualMachineInstance) []operatormet
 
 	return results
 }
+
+func collectVMILauncherImage(vmi *k6tv1.VirtualMachineInstance) []operatormetrics.CollectorResult {
+	if vmi.Status.LauncherContainerImageVersion == "" {
+		return nil
+	}
+
+	return []operatormetrics.CollectorResult{{
+		Metric: vmiLauncherImage,
+		Labels: []string{vmi.Namespace, vmi.Name, vmi.Status.LauncherContainerImageVersion},
+		Value:  1.0,
+	}}
+}
+
+func collectVMIPriorityClass(vmi *k6tv1.VirtualMachineInstance) operatormetrics.CollectorResult {
+	priorityClass := valueNone
+	if vmi.Spec.PriorityClassName != "" {
+		priorityClass = vmi.Spec.PriorityClassName
+	}
+
+	return operatormetrics.CollectorResult{
+		Metric: vmiPriorityClass,
+		Labels: []string{vmi.Namespace, vmi.Name, priorityClass},
+		Value:  1.0,
+	}
+}
+
+func collectVMICustomHostname(vmi *k6tv1.VirtualMachineInstance) []operatormetrics.CollectorResult {
+	if vmi.Spec.Hostname == "" && vmi.Spec.Subdomain == "" {
+		return nil
+	}
+
+	return []operatormetrics.CollectorResult{{
+		Metric: vmiCustomHostname,
+		Labels: []string{vmi.Namespace, vmi.Name},
+		Value:  1.0,
+	}}
+}
+
+func collectVMITerminationGracePeriod(vmi *k6tv1.VirtualMachineInstance) operatormetrics.CollectorResult {
+	gracePeriodSeconds := k6tv1.DefaultGracePeriodSeconds
+	if vmi.Spec.TerminationGracePeriodSeconds != nil {
+		gracePeriodSeconds = *vmi.Spec.TerminationGracePeriodSeconds
+	}
+
+	return operatormetrics.CollectorResult{
+		Metric: vmiTerminationGracePeriod,
+		Labels: []string{vmi.Namespace, vmi.Name},
+		Value:  float64(gracePeriodSeconds),
+	}
+}
+
+func collectVMIReady(vmi *k6tv1.VirtualMachineInstance) operatormetrics.CollectorResult {
+	ready := 0.0
+	if controller.NewVirtualMachineInstanceConditionManager().
+		HasConditionWithStatus(vmi, k6tv1.VirtualMachineInstanceReady, k8sv1.ConditionTrue) {
+		ready = 1.0
+	}
+
+	return operatormetrics.CollectorResult{
+		Metric: vmiReady,
+		Labels: []string{vmi.Namespace, vmi.Name},
+		Value:  ready,
+	}
+}
+
+func collectVMIMigrationPolicy(vmi *k6tv1.VirtualMachineInstance) []operatormetrics.CollectorResult {
+	// The migration policy is only resolved once the VMI gets migrated
+	if vmi.Status.MigrationState == nil {
+		return nil
+	}
+
+	policy := "default"
+	if policyName := vmi.Status.MigrationState.MigrationPolicyName; policyName != nil && *policyName != "" {
+		policy = *policyName
+	}
+
+	return []operatormetrics.CollectorResult{{
+		Metric: vmiMigrationPolicy,
+		Labels: []string{vmi.Namespace, vmi.Name, policy},
+		Value:  1.0,
+	}}
+}
+
+func collectVMIRealtime(vmi *k6tv1.VirtualMachineInstance) []operatormetrics.CollectorResult {
+	if vmi.Spec.Domain.CPU == nil || vmi.Spec.Domain.CPU.Realtime == nil {
+		return nil
+	}
+
+	return []operatormetrics.CollectorResult{{
+		Metric: vmiRealtime,
+		Labels: []string{vmi.Namespace, vmi.Name},
+		Value:  1.0,
+	}}
+}
+
+func collectVMIDNSPolicy(vmi *k6tv1.VirtualMachineInstance) operatormetrics.CollectorResult {
+	dnsPolicy := k8sv1.DNSClusterFirst
+	if vmi.Spec.DNSPolicy != "" {
+		dnsPolicy = vmi.Spec.DNSPolicy
+	}
+
+	return operatormetrics.CollectorResult{
+		Metric: vmiDNSPolicy,
+		Labels: []string{vmi.Namespace, vmi.Name, string(dnsPolicy)},
+		Value:  1.0,
+	}
+}
+
+func collectVMIInstancetype(vmi *k6tv1.VirtualMachineInstance) operatormetrics.CollectorResult {
+	instancetype := getVMIInstancetype(vmi)
+	if instancetype == none {
+		instancetype = custom
+	}
+
+	preference := getVMIPreference(vmi)
+	if preference == none {
+		preference = custom
+	}
+
+	return operatormetrics.CollectorResult{
+		Metric: vmiInstancetype,
+		Labels: []string{vmi.Namespace, vmi.Name, instancetype, preference},
+		Value:  1.0,
+	}
+}
+
+func collectVMIDesktopDevices(vmi *k6tv1.VirtualMachineInstance) []operatormetrics.CollectorResult {
+	var results []operatormetrics.CollectorResult
+
+	devices := vmi.Spec.Domain.Devices
+	configuredDevices := []struct {
+		device     string
+		configured bool
+	}{
+		{"sound", devices.Sound != nil},
+		{"video", devices.Video != nil},
+		{"input", len(devices.Inputs) > 0},
+	}
+
+	for _, d := range configuredDevices {
+		if !d.configured {
+			continue
+		}
+
+		results = append(results, operatormetrics.CollectorResult{
+			Metric: vmiDesktopDevices,
+			Labels: []string{vmi.Namespace, vmi.Name, d.device},
+			Value:  1.0,
+		})
+	}
+
+	return results
+}
+
+func collectVMIGPUCount(vmi *k6tv1.VirtualMachineInstance) []operatormetrics.CollectorResult {
+	var deviceNames []string
+	gpuCount := map[string]int{}
+
+	for _, gpu := range vmi.Spec.Domain.Devices.GPUs {
+		deviceName := gpu.DeviceName
+		if deviceName == "" {
+			deviceName = valueNone
+		}
+
+		if _, exists := gpuCount[deviceName]; !exists {
+			deviceNames = append(deviceNames, deviceName)
+		}
+		gpuCount[deviceName]++
+	}
+
+	var results []operatormetrics.CollectorResult
+	for _, deviceName := range deviceNames {
+		results = append(results, operatormetrics.CollectorResult{
+			Metric: vmiGPUCount,
+			Labels: []string{vmi.Namespace, vmi.Name, deviceName},
+			Value:  float64(gpuCount[deviceName]),
+		})
+	}
+
+	return results
+}
+
+func collectVMIMemoryBalloon(vmi *k6tv1.VirtualMachineInstance) []operatormetrics.CollectorResult {
+	// The memory balloon device is attached unless explicitly disabled
+	if autoattach := vmi.Spec.Domain.Devices.AutoattachMemBalloon; autoattach != nil && !*autoattach {
+		return nil
+	}
+
+	return []operatormetrics.CollectorResult{{
+		Metric: vmiMemoryBalloon,
+		Labels: []string{vmi.Namespace, vmi.Name},
+		Value:  1.0,
+	}}
+}
+
+func collectVMINetworkQueueCount(vmi *k6tv1.VirtualMachineInstance) operatormetrics.CollectorResult {
+	queueCount := multiqueue.QueuesCapacity(vmi)
+	if queueCount == 0 {
+		queueCount = 1
+	}
+
+	return operatormetrics.CollectorResult{
+		Metric: vmiNetworkQueueCount,
+		Labels: []string{vmi.Namespace, vmi.Name},
+		Value:  float64(queueCount),
+	}
+}
+
+func collectVMIMemoryLimitRequestGap(vmi *k6tv1.VirtualMachineInstance) operatormetrics.CollectorResult {
+	var gap int64
+
+	resources := vmi.Spec.Domain.Resources
+	if limit, hasLimit := resources.Limits[k8sv1.ResourceMemory]; hasLimit {
+		if request, hasRequest := resources.Requests[k8sv1.ResourceMemory]; hasRequest {
+			limit.Sub(request)
+			gap = limit.Value()
+		}
+	}
+
+	return operatormetrics.CollectorResult{
+		Metric: vmiMemoryLimitRequestGap,
+		Labels: []string{vmi.Namespace, vmi.Name},
+		Value:  float64(gap),
+	}
+}
+
+func collectVMISSHKeySource(vmi *k6tv1.VirtualMachineInstance) []operatormetrics.CollectorResult {
+	var sources []string
+
+	for _, credential := range vmi.Spec.AccessCredentials {
+		if credential.SSHPublicKey == nil {
+			continue
+		}
+
+		source := getSSHKeySource(credential.SSHPublicKey.PropagationMethod)
+		if !slices.Contains(sources, source) {
+			sources = append(sources, source)
+		}
+	}
+
+	if len(sources) == 0 {
+		sources = append(sources, "none")
+	}
+
+	results := make([]operatormetrics.CollectorResult, 0, len(sources))
+	for _, source := range sources {
+		results = append(results, operatormetrics.CollectorResult{
+			Metric: vmiSSHKeySource,
+			Labels: []string{vmi.Namespace, vmi.Name, source},
+			Value:  1.0,
+		})
+	}
+
+	return results
+}
+
+func getSSHKeySource(propagationMethod k6tv1.SSHPublicKeyAccessCredentialPropagationMethod) string {
+	if propagationMethod.QemuGuestAgent != nil {
+		return "secret"
+	}
+
+	return "static"
+}
+
+func collectVMIFirmwareFeatures(vmi *k6tv1.VirtualMachineInstance) []operatormetrics.CollectorResult {
+	// Like the domain converter, no firmware feature is enabled without a features section,
+	// not even ACPI, which is otherwise enabled by default
+	features := vmi.Spec.Domain.Features
+	if features == nil {
+		return nil
+	}
+
+	var results []operatormetrics.CollectorResult
+	enabledFeatures := []struct {
+		feature string
+		enabled bool
+	}{
+		{"smm", features.SMM != nil && isFeatureStateEnabled(*features.SMM)},
+		{"acpi", isFeatureStateEnabled(features.ACPI)},
+		{"hyperv", features.Hyperv != nil ||
+			(features.HypervPassthrough != nil && features.HypervPassthrough.Enabled != nil && *features.HypervPassthrough.Enabled)},
+	}
+
+	for _, f := range enabledFeatures {
+		if !f.enabled {
+			continue
+		}
+
+		results = append(results, operatormetrics.CollectorResult{
+			Metric: vmiFirmwareFeatures,
+			Labels: []string{vmi.Namespace, vmi.Name, f.feature},
+			Value:  1.0,
+		})
+	}
+
+	return results
+}
+
+// isFeatureStateEnabled follows the API default, where a feature whose
+// state does not set Enabled is considered enabled.
+func isFeatureStateEnabled(state k6tv1.FeatureState) bool {
+	return state.Enabled == nil || *state.Enabled
+}
+
+func collectVMIWatchdog(vmi *k6tv1.VirtualMachineInstance) []operatormetrics.CollectorResult {
+	watchdog := vmi.Spec.Domain.Devices.Watchdog
+	if watchdog == nil {
+		return nil
+	}
+
+	return []operatormetrics.CollectorResult{{
+		Metric: vmiWatchdog,
+		Labels: []string{vmi.Namespace, vmi.Name, string(getWatchdogAction(watchdog.WatchdogDevice))},
+		Value:  1.0,
+	}}
+}
+
+func getWatchdogAction(device k6tv1.WatchdogDevice) k6tv1.WatchdogAction {
+	var action k6tv1.WatchdogAction
+
+	switch {
+	case device.I6300ESB != nil:
+		action = device.I6300ESB.Action
+	case device.Diag288 != nil:
+		action = device.Diag288.Action
+	}
+
+	if action == "" {
+		return k6tv1.WatchdogActionReset
+	}
+
+	return action
+}
+
+func collectVMILauncherCPUOvercommit(vmi *k6tv1.VirtualMachineInstance) operatormetrics.CollectorResult {
+	return operatormetrics.CollectorResult{
+		Metric: vmiLauncherCPUOvercommit,
+		Labels: []string{vmi.Namespace, vmi.Name},
+		Value:  getLauncherCPUOvercommit(vmi),
+	}
+}
+
+// getLauncherCPUOvercommit mirrors the resource renderer, which only divides
+// the vCPU count by the allocation ratio for VMIs without dedicated CPUs that
+// do not request CPU explicitly.
+func getLauncherCPUOvercommit(vmi *k6tv1.VirtualMachineInstance) float64 {
+	if vmi.IsCPUDedicated() {
+		return 1.0
+	}
+
+	if _, hasCPURequest := vmi.Spec.Domain.Resources.Requests[k8sv1.ResourceCPU]; hasCPURequest {
+		return 1.0
+	}
+
+	ratio := clusterConfig.GetCPUAllocationRatio()
+	if ratio <= 0 {
+		return 1.0
+	}
+
+	return float64(ratio)
+}
+
+func collectVMISecurityProfile(vmi *k6tv1.VirtualMachineInstance) []operatormetrics.CollectorResult {
+	pod := getVMIActivePod(vmi)
+	if pod == nil {
+		return nil
+	}
+
+	var results []operatormetrics.CollectorResult
+
+	podSecurityContext := pod.Spec.SecurityContext
+	if podSecurityContext == nil {
+		podSecurityContext = &k8sv1.PodSecurityContext{}
+	}
+
+	var containerSecurityContext *k8sv1.SecurityContext
+	for _, container := range pod.Spec.Containers {
+		if container.Name == "compute" {
+			containerSecurityContext = container.SecurityContext
+			break
+		}
+	}
+	if containerSecurityContext == nil {
+		containerSecurityContext = &k8sv1.SecurityContext{}
+	}
+
+	configuredProfiles := []struct {
+		profileType string
+		configured  bool
+	}{
+		{"seccomp", isSeccompProfileConfigured(podSecurityContext.SeccompProfile) ||
+			isSeccompProfileConfigured(containerSecurityContext.SeccompProfile)},
+		{"apparmor", isAppArmorProfileConfigured(podSecurityContext.AppArmorProfile) ||
+			isAppArmorProfileConfigured(containerSecurityContext.AppArmorProfile)},
+		{"selinux", podSecurityContext.SELinuxOptions != nil || containerSecurityContext.SELinuxOptions != nil},
+	}
+
+	for _, p := range configuredProfiles {
+		if !p.configured {
+			continue
+		}
+
+		results = append(results, operatormetrics.CollectorResult{
+			Metric: vmiSecurityProfile,
+			Labels: []string{vmi.Namespace, vmi.Name, p.profileType},
+			Value:  1.0,
+		})
+	}
+
+	return results
+}
+
+func isSeccompProfileConfigured(profile *k8sv1.SeccompProfile) bool {
+	return profile != nil && profile.Type != k8sv1.SeccompProfileTypeUnconfined
+}
+
+func isAppArmorProfileConfigured(profile *k8sv1.AppArmorProfile) bool {
+	return profile != nil && profile.Type != k8sv1.AppArmorProfileTypeUnconfined
+}
+
+func collectVMIDiskErrorPolicyCount(vmi *k6tv1.VirtualMachineInstance) []operatormetrics.CollectorResult {
+	var policies []k6tv1.DiskErrorPolicy
+	policyCount := map[k6tv1.DiskErrorPolicy]int{}
+
+	for _, disk := range vmi.Spec.Domain.Devices.Disks {
+		// Matches the policy applied by the domain converter when none is set
+		policy := k6tv1.DiskErrorPolicyStop
+		if disk.ErrorPolicy != nil {
+			policy = *disk.ErrorPolicy
+		}
+
+		if _, exists := policyCount[policy]; !exists {
+			policies = append(policies, policy)
+		}
+		policyCount[policy]++
+	}
+
+	var results []operatormetrics.CollectorResult
+	for _, policy := range policies {
+		results = append(results, operatormetrics.CollectorResult{
+			Metric: vmiDiskErrorPolicyCount,
+			Labels: []string{vmi.Namespace, vmi.Name, string(policy)},
+			Value:  float64(policyCount[policy]),
+		})
+	}
+
+	return results
+}
+
+func collectVMITolerationsCount(vmi *k6tv1.VirtualMachineInstance) operatormetrics.CollectorResult {
+	return operatormetrics.CollectorResult{
+		Metric: vmiTolerationsCount,
+		Labels: []string{vmi.Namespace, vmi.Name},
+		Value:  float64(len(vmi.Spec.Tolerations)),
+	}
+}
+
+// collectVMIAge reports the age of the VMI at now, which is shared by all the VMIs of a scrape.
+func collectVMIAge(vmi *k6tv1.VirtualMachineInstance, now time.Time) []operatormetrics.CollectorResult {
+	if vmi.CreationTimestamp.IsZero() {
+		return nil
+	}
+
+	return []operatormetrics.CollectorResult{{
+		Metric: vmiAge,
+		Labels: []string{vmi.Namespace, vmi.Name},
+		Value:  now.Sub(vmi.CreationTimestamp.Time).Seconds(),
+	}}
+}
+
+func collectVMISidecarCount(vmi *k6tv1.VirtualMachineInstance) []operatormetrics.CollectorResult {
+	hookSidecarList, err := hooks.UnmarshalHookSidecarList(vmi)
+	if err != nil {
+		log.Log.Object(vmi).V(logVerbosityDebug).Reason(err).Infof("failed to parse the hook sidecar list")
+		return nil
+	}
+
+	return []operatormetrics.CollectorResult{{
+		Metric: vmiSidecarCount,
+		Labels: []string{vmi.Namespace, vmi.Name},
+		Value:  float64(len(hookSidecarList)),
+	}}
+}
+
+func collectVMINodeSelectorCount(vmi *k6tv1.VirtualMachineInstance) operatormetrics.CollectorResult {
+	return operatormetrics.CollectorResult{
+		Metric: vmiNodeSelectorCount,
+		Labels: []string{vmi.Namespace, vmi.Name},
+		Value:  float64(len(vmi.Spec.NodeSelector)),
+	}
+}
+
+func collectVMIEphemeralHotplugVolumeCount(vmi *k6tv1.VirtualMachineInstance) []operatormetrics.CollectorResult {
+	volumeNames, err := getEphemeralHotplugVolumes(vmi)
+	if err != nil {
+		log.Log.Object(vmi).V(logVerbosityDebug).Reason(err).Infof("failed to parse the ephemeral hotplug volume list")
+		return nil
+	}
+
+	if len(volumeNames) == 0 {
+		return nil
+	}
+
+	return []operatormetrics.CollectorResult{{
+		Metric: vmiEphemeralHotplugVolumeCount,
+		Labels: []string{vmi.Namespace, vmi.Name},
+		Value:  float64(len(volumeNames)),
+	}}
+}
+
+func collectVMIEphemeralHotplugVolumeSize(vmi *k6tv1.VirtualMachineInstance) []operatormetrics.CollectorResult {
+	if stores == nil || stores.PersistentVolumeClaim == nil {
+		return nil
+	}
+
+	volumeNames, err := getEphemeralHotplugVolumes(vmi)
+	if err != nil || len(volumeNames) == 0 {
+		return nil
+	}
+
+	var results []operatormetrics.CollectorResult
+	for _, volume := range vmi.Spec.Volumes {
+		if !slices.Contains(volumeNames, volume.Name) {
+			continue
+		}
+
+		claimName, source := getEphemeralHotplugVolumeClaim(volume)
+		if claimName == "" {
+			continue
+		}
+
+		obj, exists, err := stores.PersistentVolumeClaim.GetByKey(controller.NamespacedKey(vmi.Namespace, claimName))
+		if err != nil {
+			log.Log.Object(vmi).Reason(err).Errorf("failed to get PVC %s of ephemeral hotplug volume %s", claimName, volume.Name)
+			continue
+		}
+		if !exists {
+			continue
+		}
+
+		pvc, ok := obj.(*k8sv1.PersistentVolumeClaim)
+		if !ok {
+			continue
+		}
+
+		size, ok := pvc.Status.Capacity[k8sv1.ResourceStorage]
+		if !ok {
+			size = *pvc.Spec.Resources.Requests.Storage()
+		}
+
+		storageClass := ""
+		if pvc.Spec.StorageClassName != nil {
+			storageClass = *pvc.Spec.StorageClassName
+		}
+
+		results = append(results, operatormetrics.CollectorResult{
+			Metric: vmiEphemeralHotplugVolumeSize,
+			Labels: []string{vmi.Namespace, vmi.Name, volume.Name, source, storageClass},
+			Value:  float64(size.Value()),
+		})
+	}
+
+	return results
+}
+
+func getEphemeralHotplugVolumeClaim(volume k6tv1.Volume) (claimName, source string) {
+	if volume.PersistentVolumeClaim != nil {
+		return volume.PersistentVolumeClaim.ClaimName, "pvc"
+	}
+	if volume.DataVolume != nil {
+		return volume.DataVolume.Name, "datavolume"
+	}
+	return "", ""
+}
+
+func getEphemeralHotplugVolumes(vmi *k6tv1.VirtualMachineInstance) ([]string, error) {
+	rawVolumes, exists := vmi.GetAnnotations()[k6tv1.EphemeralHotplugAnnotation]
+	if !exists {
+		return nil, nil
+	}
+
+	var volumeNames []string
+	if err := json.Unmarshal([]byte(rawVolumes), &volumeNames); err != nil {
+		return nil, err
+	}
+
+	return volumeNames, nil
+}
+
+func collectVMIBackendStorage(vmi *k6tv1.VirtualMachineInstance) []operatormetrics.CollectorResult {
+	if backendstorage.CurrentPVCName(vmi) == "" {
+		return nil
+	}
+
+	return []operatormetrics.CollectorResult{{
+		Metric: vmiBackendStorage,
+		Labels: []string{vmi.Namespace, vmi.Name},
+		Value:  1.0,
+	}}
+}
+
+func collectVMIPinnedVCPUCount(vmi *k6tv1.VirtualMachineInstance) operatormetrics.CollectorResult {
+	var pinnedVCPUs int64
+	if vmi.IsCPUDedicated() {
+		pinnedVCPUs = hardware.GetNumberOfVCPUs(vmi.Spec.Domain.CPU)
+	}
+
+	return operatormetrics.CollectorResult{
+		Metric: vmiPinnedVCPUCount,
+		Labels: []string{vmi.Namespace, vmi.Name},
+		Value:  float64(pinnedVCPUs),
+	}
+}
+
+func collectVMILauncherCPURequest(vmi *k6tv1.VirtualMachineInstance) []operatormetrics.CollectorResult {
+	pod := getVMIActivePod(vmi)
+	if pod == nil {
+		return nil
+	}
+
+	var cpuRequestMillicores int64
+	for _, container := range pod.Spec.Containers {
+		if cpuRequest, exists := container.Resources.Requests[k8sv1.ResourceCPU]; exists {
+			cpuRequestMillicores += cpuRequest.MilliValue()
+		}
+	}
+
+	return []operatormetrics.CollectorResult{{
+		Metric: vmiLauncherCPURequest,
+		Labels: []string{vmi.Namespace, vmi.Name},
+		Value:  float64(cpuRequestMillicores),
+	}}
+}
+
+func collectVMIGPUDisplayEnabled(vmi *k6tv1.VirtualMachineInstance) []operatormetrics.CollectorResult {
+	for _, gpu := range vmi.Spec.Domain.Devices.GPUs {
+		if isGPUDisplayEnabled(gpu) {
+			return []operatormetrics.CollectorResult{{
+				Metric: vmiGPUDisplayEnabled,
+				Labels: []string{vmi.Namespace, vmi.Name},
+				Value:  1.0,
+			}}
+		}
+	}
+
+	return nil
+}
+
+// isGPUDisplayEnabled matches the host device converter, where a configured
+// display defaults to enabled.
+func isGPUDisplayEnabled(gpu k6tv1.GPU) bool {
+	if gpu.VirtualGPUOptions == nil || gpu.VirtualGPUOptions.Display == nil {
+		return false
+	}
+
+	enabled := gpu.VirtualGPUOptions.Display.Enabled
+	return enabled == nil || *enabled
+}
+
+func collectVMIDiskDedicatedIOThreadCount(vmi *k6tv1.VirtualMachineInstance) operatormetrics.CollectorResult {
+	count := 0
+	for _, disk := range vmi.Spec.Domain.Devices.Disks {
+		if disk.DedicatedIOThread != nil && *disk.DedicatedIOThread {
+			count++
+		}
+	}
+
+	return operatormetrics.CollectorResult{
+		Metric: vmiDiskDedicatedIOThreadCount,
+		Labels: []string{vmi.Namespace, vmi.Name},
+		Value:  float64(count),
+	}
+}
+
+func collectVMIMemoryOvercommitFactor(vmi *k6tv1.VirtualMachineInstance) []operatormetrics.CollectorResult {
+	resources := vmi.Spec.Domain.Resources
+	limit, hasLimit := resources.Limits[k8sv1.ResourceMemory]
+	if !hasLimit || limit.IsZero() {
+		return nil
+	}
+
+	var request int64
+	if memoryRequest, hasRequest := resources.Requests[k8sv1.ResourceMemory]; hasRequest {
+		request = memoryRequest.Value()
+	}
+
+	return []operatormetrics.CollectorResult{{
+		Metric: vmiMemoryOvercommitFactor,
+		Labels: []string{vmi.Namespace, vmi.Name},
+		Value:  float64(request+getLauncherMemoryOverheadBytes(vmi)) / float64(limit.Value()),
+	}}
+}
+
+func collectVMIMigrationDuration(vmi *k6tv1.VirtualMachineInstance) []operatormetrics.CollectorResult {
+	migrationState := vmi.Status.MigrationState
+	if migrationState == nil || migrationState.StartTimestamp == nil || migrationState.EndTimestamp == nil {
+		return nil
+	}
+
+	return []operatormetrics.CollectorResult{{
+		Metric: vmiMigrationDuration,
+		Labels: []string{
+			vmi.Status.NodeName, vmi.Namespace, vmi.Name,
+			getMigrationNameFromMigrationUID(migrationState.MigrationUID),
+			calculateMigrationStatus(migrationState),
+		},
+		Value: migrationState.EndTimestamp.Sub(migrationState.StartTimestamp.Time).Seconds(),
+	}}
+}
+
+var memoryDumpPhases = map[k6tv1.VolumePhase]string{
+	k6tv1.MemoryDumpVolumeInProgress: "InProgress",
+	k6tv1.MemoryDumpVolumeCompleted:  "Completed",
+	k6tv1.MemoryDumpVolumeFailed:     "Failed",
+}
+
+func collectVMIMemoryDumpDuration(vmi *k6tv1.VirtualMachineInstance) []operatormetrics.CollectorResult {
+	var crs []operatormetrics.CollectorResult
+
+	for _, volumeStatus := range vmi.Status.VolumeStatus {
+		memoryDump := volumeStatus.MemoryDumpVolume
+		if memoryDump == nil || memoryDump.StartTimestamp == nil {
+			continue
+		}
+
+		phase, ok := memoryDumpPhases[volumeStatus.Phase]
+		if !ok {
+			continue
+		}
+
+		end := time.Now()
+		if memoryDump.EndTimestamp != nil {
+			end = memoryDump.EndTimestamp.Time
+		}
+
+		crs = append(crs, operatormetrics.CollectorResult{
+			Metric: vmiMemoryDumpDuration,
+			Labels: []string{vmi.Namespace, vmi.Name, memoryDump.ClaimName, phase},
+			Value:  end.Sub(memoryDump.StartTimestamp.Time).Seconds(),
+		})
+	}
+
+	return crs
+}
@@ -20,6 +20,8 @@
 package virtcontroller
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
@@ -34,10 +36,14 @@ import (
 	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
 
 	virtcontroller "kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/hooks"
 	"kubevirt.io/kubevirt/pkg/instancetype/apply"
 	"kubevirt.io/kubevirt/pkg/instancetype/find"
 	preferencefind "kubevirt.io/kubevirt/pkg/instancetype/preference/find"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	backendstorage "kubevirt.io/kubevirt/pkg/storage/backend-storage"
 	"kubevirt.io/kubevirt/pkg/testutils"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 )
 
 var _ = BeforeSuite(func() {
@@ -576,6 +582,1474 @@ var _ = Describe("VMI Stats Collector", func() {
 
 			Expect(metric1.Value).To(BeNumerically("<", metric2.Value))
 		})
+
+		It("should report multi-terabyte overheads without losing precision", func() {
+			const overheadBytes = 4*1024*1024*1024*1024 + 1
+
+			vmi := &k6tv1.VirtualMachineInstance{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test-ns",
+					Name:      "test-vmi",
+				},
+				Status: k6tv1.VirtualMachineInstanceStatus{
+					Memory: &k6tv1.MemoryStatus{
+						MemoryOverhead: resource.NewQuantity(overheadBytes, resource.BinarySI),
+					},
+				},
+			}
+
+			metric := collectVMILauncherMemoryOverhead(vmi)
+			Expect(metric.Value).To(Equal(float64(overheadBytes)))
+			Expect(int64(metric.Value)).To(Equal(vmi.Status.Memory.MemoryOverhead.Value()))
+		})
+
+		DescribeTable("should collect kubevirt_vmi_launcher_overhead_class metric", func(overhead, expectedClass string) {
+			vmi := &k6tv1.VirtualMachineInstance{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test-ns",
+					Name:      "test-vmi",
+				},
+			}
+
+			overheadQuantity := resource.MustParse(overhead)
+			metric := collectVMILauncherOverheadClass(vmi, float64(overheadQuantity.Value()))
+
+			Expect(metric.Metric.GetOpts().Name).To(Equal("kubevirt_vmi_launcher_overhead_class"))
+			Expect(metric.Labels).To(Equal([]string{"test-ns", "test-vmi", expectedClass}))
+			Expect(metric.Value).To(BeEquivalentTo(1))
+		},
+			Entry("below 128Mi", "100Mi", "<128Mi"),
+			Entry("at the 128Mi boundary", "128Mi", "128-256Mi"),
+			Entry("between 128Mi and 256Mi", "200Mi", "128-256Mi"),
+			Entry("at the 256Mi boundary", "256Mi", "128-256Mi"),
+			Entry("above 256Mi", "300Mi", ">256Mi"),
+		)
+
+		It("should report the size class matching the reported overhead", func() {
+			vmi := &k6tv1.VirtualMachineInstance{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test-ns",
+					Name:      "test-vmi",
+				},
+				Status: k6tv1.VirtualMachineInstanceStatus{
+					Memory: &k6tv1.MemoryStatus{
+						MemoryOverhead: pointer.P(resource.MustParse("300Mi")),
+					},
+				},
+			}
+
+			crs := reportVmisStats([]*k6tv1.VirtualMachineInstance{vmi})
+
+			var classLabels [][]string
+			for _, cr := range crs {
+				if cr.Metric.GetOpts().Name == "kubevirt_vmi_launcher_overhead_class" {
+					classLabels = append(classLabels, cr.Labels)
+				}
+			}
+			Expect(classLabels).To(Equal([][]string{{"test-ns", "test-vmi", ">256Mi"}}))
+		})
+	})
+
+	Context("VMI launcher image", func() {
+		It("should collect kubevirt_vmi_launcher_image metric with the active launcher image", func() {
+			vmi := &k6tv1.VirtualMachineInstance{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test-ns",
+					Name:      "test-vmi",
+				},
+				Status: k6tv1.VirtualMachineInstanceStatus{
+					LauncherContainerImageVersion: "quay.io/kubevirt/virt-launcher:v1.5.0",
+				},
+			}
+
+			metrics := collectVMILauncherImage(vmi)
+			Expect(metrics).To(HaveLen(1))
+			Expect(metrics[0].Metric.GetOpts().Name).To(Equal("kubevirt_vmi_launcher_image"))
+			Expect(metrics[0].Labels).To(Equal([]string{"test-ns", "test-vmi", "quay.io/kubevirt/virt-launcher:v1.5.0"}))
+			Expect(metrics[0].Value).To(BeEquivalentTo(1))
+		})
+
+		It("should not collect kubevirt_vmi_launcher_image metric when the launcher image is not known yet", func() {
+			vmi := &k6tv1.VirtualMachineInstance{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test-ns",
+					Name:      "test-vmi",
+				},
+			}
+
+			Expect(collectVMILauncherImage(vmi)).To(BeEmpty())
+		})
+	})
+
+	Context("VMI priority class", func() {
+		DescribeTable("should collect kubevirt_vmi_priority_class metric", func(priorityClassName, expectedLabel string) {
+			vmi := &k6tv1.VirtualMachineInstance{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test-ns",
+					Name:      "test-vmi",
+				},
+				Spec: k6tv1.VirtualMachineInstanceSpec{
+					PriorityClassName: priorityClassName,
+				},
+			}
+
+			metric := collectVMIPriorityClass(vmi)
+			Expect(metric.Metric.GetOpts().Name).To(Equal("kubevirt_vmi_priority_class"))
+			Expect(metric.Labels).To(Equal([]string{"test-ns", "test-vmi", expectedLabel}))
+			Expect(metric.Value).To(BeEquivalentTo(1))
+		},
+			Entry("with a priority class", "high-priority", "high-priority"),
+			Entry("without a priority class", "", "<none>"),
+		)
+	})
+
+	Context("VMI custom hostname", func() {
+		DescribeTable("kubevirt_vmi_custom_hostname metric", func(hostname, subdomain string, expectMetric bool) {
+			vmi := &k6tv1.VirtualMachineInstance{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test-ns",
+					Name:      "test-vmi",
+				},
+				Spec: k6tv1.VirtualMachineInstanceSpec{
+					Hostname:  hostname,
+					Subdomain: subdomain,
+				},
+			}
+
+			metrics := collectVMICustomHostname(vmi)
+			if !expectMetric {
+				Expect(metrics).To(BeEmpty())
+				return
+			}
+
+			Expect(metrics).To(HaveLen(1))
+			Expect(metrics[0].Metric.GetOpts().Name).To(Equal("kubevirt_vmi_custom_hostname"))
+			Expect(metrics[0].Labels).To(Equal([]string{"test-ns", "test-vmi"}))
+			Expect(metrics[0].Value).To(BeEquivalentTo(1))
+		},
+			Entry("should be reported when hostname is set", "my-host", "", true),
+			Entry("should be reported when subdomain is set", "", "my-subdomain", true),
+			Entry("should be reported when both are set", "my-host", "my-subdomain", true),
+			Entry("should not be reported when neither is set", "", "", false),
+		)
+	})
+
+	Context("VMI termination grace period", func() {
+		DescribeTable("should collect kubevirt_vmi_termination_grace_period_seconds metric", func(gracePeriod *int64, expectedValue float64) {
+			vmi := &k6tv1.VirtualMachineInstance{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test-ns",
+					Name:      "test-vmi",
+				},
+				Spec: k6tv1.VirtualMachineInstanceSpec{
+					TerminationGracePeriodSeconds: gracePeriod,
+				},
+			}
+
+			metric := collectVMITerminationGracePeriod(vmi)
+			Expect(metric.Metric.GetOpts().Name).To(Equal("kubevirt_vmi_termination_grace_period_seconds"))
+			Expect(metric.Labels).To(Equal([]string{"test-ns", "test-vmi"}))
+			Expect(metric.Value).To(Equal(expectedValue))
+		},
+			Entry("with a configured grace period", pointer.P(int64(180)), 180.0),
+			Entry("with a zero grace period", pointer.P(int64(0)), 0.0),
+			Entry("without a grace period, reporting the default", nil, 30.0),
+		)
+	})
+
+	Context("VMI ready", func() {
+		DescribeTable("should collect kubevirt_vmi_ready metric", func(conditions []k6tv1.VirtualMachineInstanceCondition, expectedValue float64) {
+			vmi := &k6tv1.VirtualMachineInstance{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test-ns",
+					Name:      "test-vmi",
+				},
+				Status: k6tv1.VirtualMachineInstanceStatus{
+					Conditions: conditions,
+				},
+			}
+
+			metric := collectVMIReady(vmi)
+			Expect(metric.Metric.GetOpts().Name).To(Equal("kubevirt_vmi_ready"))
+			Expect(metric.Labels).To(Equal([]string{"test-ns", "test-vmi"}))
+			Expect(metric.Value).To(Equal(expectedValue))
+		},
+			Entry("when the Ready condition is true",
+				[]k6tv1.VirtualMachineInstanceCondition{{Type: k6tv1.VirtualMachineInstanceReady, Status: k8sv1.ConditionTrue}}, 1.0),
+			Entry("when the Ready condition is false",
+				[]k6tv1.VirtualMachineInstanceCondition{{Type: k6tv1.VirtualMachineInstanceReady, Status: k8sv1.ConditionFalse}}, 0.0),
+			Entry("when the Ready condition is not set", nil, 0.0),
+		)
+	})
+
+	Context("VMI migration policy", func() {
+		It("should not collect kubevirt_vmi_migration_policy metric for a VMI that was never migrated", func() {
+			vmi := &k6tv1.VirtualMachineInstance{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test-ns",
+					Name:      "test-vmi",
+				},
+			}
+
+			Expect(collectVMIMigrationPolicy(vmi)).To(BeEmpty())
+		})
+
+		DescribeTable("should collect kubevirt_vmi_migration_policy metric", func(policyName *string, expectedPolicy string) {
+			vmi := &k6tv1.VirtualMachineInstance{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test-ns",
+					Name:      "test-vmi",
+				},
+				Status: k6tv1.VirtualMachineInstanceStatus{
+					MigrationState: &k6tv1.VirtualMachineInstanceMigrationState{
+						MigrationPolicyName: policyName,
+					},
+				},
+			}
+
+			metrics := collectVMIMigrationPolicy(vmi)
+			Expect(metrics).To(HaveLen(1))
+			Expect(metrics[0].Metric.GetOpts().Name).To(Equal("kubevirt_vmi_migration_policy"))
+			Expect(metrics[0].Labels).To(Equal([]string{"test-ns", "test-vmi", expectedPolicy}))
+			Expect(metrics[0].Value).To(BeEquivalentTo(1))
+		},
+			Entry("with a matched policy", pointer.P("fast-network"), "fast-network"),
+			Entry("with no matched policy", nil, "default"),
+			Entry("with an empty policy name", pointer.P(""), "default"),
+		)
+	})
+
+	Context("VMI realtime", func() {
+		DescribeTable("kubevirt_vmi_realtime metric", func(cpu *k6tv1.CPU, expectMetric bool) {
+			vmi := &k6tv1.VirtualMachineInstance{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test-ns",
+					Name:      "test-vmi",
+				},
+				Spec: k6tv1.VirtualMachineInstanceSpec{
+					Domain: k6tv1.DomainSpec{CPU: cpu},
+				},
+			}
+
+			metrics := collectVMIRealtime(vmi)
+			if !expectMetric {
+				Expect(metrics).To(BeEmpty())
+				return
+			}
+
+			Expect(metrics).To(HaveLen(1))
+			Expect(metrics[0].Metric.GetOpts().Name).To(Equal("kubevirt_vmi_realtime"))
+			Expect(metrics[0].Labels).To(Equal([]string{"test-ns", "test-vmi"}))
+			Expect(metrics[0].Value).To(BeEquivalentTo(1))
+		},
+			Entry("should be reported when realtime is configured", &k6tv1.CPU{Realtime: &k6tv1.Realtime{}}, true),
+			Entry("should not be reported when realtime is not configured", &k6tv1.CPU{Cores: 2}, false),
+			Entry("should not be reported when no CPU is configured", nil, false),
+		)
+	})
+
+	Context("VMI DNS policy", func() {
+		DescribeTable("should collect kubevirt_vmi_dns_policy metric", func(dnsPolicy k8sv1.DNSPolicy, expectedPolicy string) {
+			vmi := &k6tv1.VirtualMachineInstance{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test-ns",
+					Name:      "test-vmi",
+				},
+				Spec: k6tv1.VirtualMachineInstanceSpec{
+					DNSPolicy: dnsPolicy,
+				},
+			}
+
+			metric := collectVMIDNSPolicy(vmi)
+			Expect(metric.Metric.GetOpts().Name).To(Equal("kubevirt_vmi_dns_policy"))
+			Expect(metric.Labels).To(Equal([]string{"test-ns", "test-vmi", expectedPolicy}))
+			Expect(metric.Value).To(BeEquivalentTo(1))
+		},
+			Entry("with the None policy", k8sv1.DNSNone, "None"),
+			Entry("with the Default policy", k8sv1.DNSDefault, "Default"),
+			Entry("without a policy, reporting the Kubernetes default", k8sv1.DNSPolicy(""), "ClusterFirst"),
+		)
+	})
+
+	Context("VMI instancetype", func() {
+		setupTestCollector()
+
+		DescribeTable("should collect kubevirt_vmi_instancetype metric",
+			func(annotations map[string]string, expectedInstancetype, expectedPreference string) {
+				vmi := &k6tv1.VirtualMachineInstance{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace:   "test-ns",
+						Name:        "test-vmi",
+						Annotations: annotations,
+					},
+				}
+
+				metric := collectVMIInstancetype(vmi)
+				Expect(metric.Metric.GetOpts().Name).To(Equal("kubevirt_vmi_instancetype"))
+				Expect(metric.Labels).To(Equal([]string{"test-ns", "test-vmi", expectedInstancetype, expectedPreference}))
+				Expect(metric.Value).To(BeEquivalentTo(1))
+			},
+			Entry("without instancetype and preference", nil, "custom", "custom"),
+			Entry("with a managed instancetype and preference",
+				map[string]string{
+					k6tv1.InstancetypeAnnotation: "i-managed",
+					k6tv1.PreferenceAnnotation:   "p-managed",
+				}, "i-managed", "p-managed"),
+			Entry("with a managed cluster instancetype and no preference",
+				map[string]string{
+					k6tv1.ClusterInstancetypeAnnotation: "ci-managed",
+				}, "ci-managed", "custom"),
+			Entry("with an unmanaged instancetype and preference",
+				map[string]string{
+					k6tv1.InstancetypeAnnotation: "i-unmanaged",
+					k6tv1.PreferenceAnnotation:   "p-unmanaged",
+				}, "<other>", "<other>"),
+		)
+	})
+
+	Context("VMI desktop devices", func() {
+		newDesktopDevicesVMI := func(devices k6tv1.Devices) *k6tv1.VirtualMachineInstance {
+			return &k6tv1.VirtualMachineInstance{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test-ns",
+					Name:      "test-vmi",
+				},
+				Spec: k6tv1.VirtualMachineInstanceSpec{
+					Domain: k6tv1.DomainSpec{Devices: devices},
+				},
+			}
+		}
+
+		It("should not collect kubevirt_vmi_desktop_devices metric when no desktop device is configured", func() {
+			Expect(collectVMIDesktopDevices(newDesktopDevicesVMI(k6tv1.Devices{}))).To(BeEmpty())
+		})
+
+		It("should collect kubevirt_vmi_desktop_devices metric for each configured device type", func() {
+			vmi := newDesktopDevicesVMI(k6tv1.Devices{
+				Sound: &k6tv1.SoundDevice{Name: "audio"},
+				Video: &k6tv1.VideoDevice{Type: "virtio"},
+				Inputs: []k6tv1.Input{
+					{Name: "tablet0", Type: k6tv1.InputTypeTablet, Bus: k6tv1.InputBusUSB},
+					{Name: "tablet1", Type: k6tv1.InputTypeTablet, Bus: k6tv1.InputBusVirtio},
+				},
+			})
+
+			metrics := collectVMIDesktopDevices(vmi)
+			Expect(metrics).To(HaveLen(3))
+
+			var labels [][]string
+			for _, metric := range metrics {
+				Expect(metric.Metric.GetOpts().Name).To(Equal("kubevirt_vmi_desktop_devices"))
+				Expect(metric.Value).To(BeEquivalentTo(1))
+				labels = append(labels, metric.Labels)
+			}
+
+			Expect(labels).To(ConsistOf(
+				[]string{"test-ns", "test-vmi", "sound"},
+				[]string{"test-ns", "test-vmi", "video"},
+				[]string{"test-ns", "test-vmi", "input"},
+			))
+		})
+
+		It("should only collect kubevirt_vmi_desktop_devices metric for the configured device types", func() {
+			vmi := newDesktopDevicesVMI(k6tv1.Devices{
+				Sound: &k6tv1.SoundDevice{Name: "audio"},
+			})
+
+			metrics := collectVMIDesktopDevices(vmi)
+			Expect(metrics).To(HaveLen(1))
+			Expect(metrics[0].Labels).To(Equal([]string{"test-ns", "test-vmi", "sound"}))
+		})
+	})
+
+	Context("VMI GPU count", func() {
+		newGPUVMI := func(gpus ...k6tv1.GPU) *k6tv1.VirtualMachineInstance {
+			return &k6tv1.VirtualMachineInstance{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test-ns",
+					Name:      "test-vmi",
+				},
+				Spec: k6tv1.VirtualMachineInstanceSpec{
+					Domain: k6tv1.DomainSpec{
+						Devices: k6tv1.Devices{GPUs: gpus},
+					},
+				},
+			}
+		}
+
+		It("should not collect kubevirt_vmi_gpu_count metric for a VMI without GPUs", func() {
+			Expect(collectVMIGPUCount(newGPUVMI())).To(BeEmpty())
+		})
+
+		It("should collect kubevirt_vmi_gpu_count metric summed by device name", func() {
+			vmi := newGPUVMI(
+				k6tv1.GPU{Name: "gpu1", DeviceName: "nvidia.com/A100"},
+				k6tv1.GPU{Name: "gpu2", DeviceName: "nvidia.com/T4"},
+				k6tv1.GPU{Name: "gpu3", DeviceName: "nvidia.com/A100"},
+				k6tv1.GPU{Name: "gpu4", ClaimRequest: &k6tv1.ClaimRequest{ClaimName: pointer.P("gpu-claim")}},
+			)
+
+			metrics := collectVMIGPUCount(vmi)
+			Expect(metrics).To(HaveLen(3))
+
+			Expect(metrics[0].Metric.GetOpts().Name).To(Equal("kubevirt_vmi_gpu_count"))
+			Expect(metrics[0].Labels).To(Equal([]string{"test-ns", "test-vmi", "nvidia.com/A100"}))
+			Expect(metrics[0].Value).To(BeEquivalentTo(2))
+			Expect(metrics[1].Labels).To(Equal([]string{"test-ns", "test-vmi", "nvidia.com/T4"}))
+			Expect(metrics[1].Value).To(BeEquivalentTo(1))
+			Expect(metrics[2].Labels).To(Equal([]string{"test-ns", "test-vmi", "<none>"}))
+			Expect(metrics[2].Value).To(BeEquivalentTo(1))
+		})
+	})
+
+	Context("VMI memory balloon", func() {
+		DescribeTable("kubevirt_vmi_memory_balloon metric", func(autoattachMemBalloon *bool, expectMetric bool) {
+			vmi := &k6tv1.VirtualMachineInstance{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test-ns",
+					Name:      "test-vmi",
+				},
+				Spec: k6tv1.VirtualMachineInstanceSpec{
+					Domain: k6tv1.DomainSpec{
+						Devices: k6tv1.Devices{AutoattachMemBalloon: autoattachMemBalloon},
+					},
+				},
+			}
+
+			metrics := collectVMIMemoryBalloon(vmi)
+			if !expectMetric {
+				Expect(metrics).To(BeEmpty())
+				return
+			}
+
+			Expect(metrics).To(HaveLen(1))
+			Expect(metrics[0].Metric.GetOpts().Name).To(Equal("kubevirt_vmi_memory_balloon"))
+			Expect(metrics[0].Labels).To(Equal([]string{"test-ns", "test-vmi"}))
+			Expect(metrics[0].Value).To(BeEquivalentTo(1))
+		},
+			Entry("should be reported when the memory balloon is attached by default", nil, true),
+			Entry("should be reported when the memory balloon is explicitly enabled", pointer.P(true), true),
+			Entry("should not be reported when the memory balloon is disabled", pointer.P(false), false),
+		)
+	})
+
+	Context("VMI network queue count", func() {
+		DescribeTable("should collect kubevirt_vmi_network_queue_count metric", func(multiQueue *bool, cores uint32, expectedValue float64) {
+			vmi := &k6tv1.VirtualMachineInstance{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test-ns",
+					Name:      "test-vmi",
+				},
+				Spec: k6tv1.VirtualMachineInstanceSpec{
+					Domain: k6tv1.DomainSpec{
+						CPU:     &k6tv1.CPU{Cores: cores, Sockets: 1, Threads: 1},
+						Devices: k6tv1.Devices{NetworkInterfaceMultiQueue: multiQueue},
+					},
+				},
+			}
+
+			metric := collectVMINetworkQueueCount(vmi)
+			Expect(metric.Metric.GetOpts().Name).To(Equal("kubevirt_vmi_network_queue_count"))
+			Expect(metric.Labels).To(Equal([]string{"test-ns", "test-vmi"}))
+			Expect(metric.Value).To(Equal(expectedValue))
+		},
+			Entry("with multi-queue enabled, one queue per vCPU", pointer.P(true), uint32(4), 4.0),
+			Entry("with multi-queue disabled", pointer.P(false), uint32(4), 1.0),
+			Entry("with multi-queue not set", nil, uint32(4), 1.0),
+		)
+	})
+
+	Context("VMI memory limit request gap", func() {
+		DescribeTable("should collect kubevirt_vmi_memory_limit_request_gap_bytes metric",
+			func(requests, limits k8sv1.ResourceList, expectedValue float64) {
+				vmi := &k6tv1.VirtualMachineInstance{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "test-ns",
+						Name:      "test-vmi",
+					},
+					Spec: k6tv1.VirtualMachineInstanceSpec{
+						Domain: k6tv1.DomainSpec{
+							Resources: k6tv1.ResourceRequirements{
+								Requests: requests,
+								Limits:   limits,
+							},
+						},
+					},
+				}
+
+				metric := collectVMIMemoryLimitRequestGap(vmi)
+				Expect(metric.Metric.GetOpts().Name).To(Equal("kubevirt_vmi_memory_limit_request_gap_bytes"))
+				Expect(metric.Labels).To(Equal([]string{"test-ns", "test-vmi"}))
+				Expect(metric.Value).To(Equal(expectedValue))
+			},
+			Entry("with a limit above the request",
+				k8sv1.ResourceList{k8sv1.ResourceMemory: resource.MustParse("1Gi")},
+				k8sv1.ResourceList{k8sv1.ResourceMemory: resource.MustParse("2Gi")},
+				float64(1024*1024*1024)),
+			Entry("with a limit equal to the request",
+				k8sv1.ResourceList{k8sv1.ResourceMemory: resource.MustParse("1Gi")},
+				k8sv1.ResourceList{k8sv1.ResourceMemory: resource.MustParse("1Gi")},
+				0.0),
+			Entry("without a limit",
+				k8sv1.ResourceList{k8sv1.ResourceMemory: resource.MustParse("1Gi")},
+				nil,
+				0.0),
+		)
+	})
+
+	Context("VMI SSH key source", func() {
+		secretSSHCredential := func(propagationMethod k6tv1.SSHPublicKeyAccessCredentialPropagationMethod) k6tv1.AccessCredential {
+			return k6tv1.AccessCredential{
+				SSHPublicKey: &k6tv1.SSHPublicKeyAccessCredential{
+					Source: k6tv1.SSHPublicKeyAccessCredentialSource{
+						Secret: &k6tv1.AccessCredentialSecretSource{SecretName: "ssh-keys"},
+					},
+					PropagationMethod: propagationMethod,
+				},
+			}
+		}
+
+		DescribeTable("should collect kubevirt_vmi_ssh_key_source metric",
+			func(accessCredentials []k6tv1.AccessCredential, expectedSources []string) {
+				vmi := &k6tv1.VirtualMachineInstance{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "test-ns",
+						Name:      "test-vmi",
+					},
+					Spec: k6tv1.VirtualMachineInstanceSpec{
+						AccessCredentials: accessCredentials,
+					},
+				}
+
+				crs := collectVMISSHKeySource(vmi)
+				Expect(crs).To(HaveLen(len(expectedSources)))
+				for i, cr := range crs {
+					Expect(cr.Metric.GetOpts().Name).To(Equal("kubevirt_vmi_ssh_key_source"))
+					Expect(cr.Labels).To(Equal([]string{"test-ns", "test-vmi", expectedSources[i]}))
+					Expect(cr.Value).To(Equal(1.0))
+				}
+			},
+			Entry("without access credentials", nil, []string{"none"}),
+			Entry("with only a user password credential",
+				[]k6tv1.AccessCredential{{UserPassword: &k6tv1.UserPasswordAccessCredential{}}},
+				[]string{"none"}),
+			Entry("with qemu guest agent propagation",
+				[]k6tv1.AccessCredential{
+					secretSSHCredential(k6tv1.SSHPublicKeyAccessCredentialPropagationMethod{
+						QemuGuestAgent: &k6tv1.QemuGuestAgentSSHPublicKeyAccessCredentialPropagation{},
+					}),
+				},
+				[]string{"secret"}),
+			Entry("with noCloud propagation",
+				[]k6tv1.AccessCredential{
+					secretSSHCredential(k6tv1.SSHPublicKeyAccessCredentialPropagationMethod{
+						NoCloud: &k6tv1.NoCloudSSHPublicKeyAccessCredentialPropagation{},
+					}),
+				},
+				[]string{"static"}),
+			Entry("with mixed propagation methods",
+				[]k6tv1.AccessCredential{
+					secretSSHCredential(k6tv1.SSHPublicKeyAccessCredentialPropagationMethod{
+						ConfigDrive: &k6tv1.ConfigDriveSSHPublicKeyAccessCredentialPropagation{},
+					}),
+					secretSSHCredential(k6tv1.SSHPublicKeyAccessCredentialPropagationMethod{
+						NoCloud: &k6tv1.NoCloudSSHPublicKeyAccessCredentialPropagation{},
+					}),
+					secretSSHCredential(k6tv1.SSHPublicKeyAccessCredentialPropagationMethod{
+						QemuGuestAgent: &k6tv1.QemuGuestAgentSSHPublicKeyAccessCredentialPropagation{},
+					}),
+				},
+				[]string{"static", "secret"}),
+		)
+	})
+
+	Context("VMI firmware features", func() {
+		DescribeTable("should collect kubevirt_vmi_firmware_features metric",
+			func(features *k6tv1.Features, expectedFeatures []string) {
+				vmi := &k6tv1.VirtualMachineInstance{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "test-ns",
+						Name:      "test-vmi",
+					},
+					Spec: k6tv1.VirtualMachineInstanceSpec{
+						Domain: k6tv1.DomainSpec{
+							Features: features,
+						},
+					},
+				}
+
+				crs := collectVMIFirmwareFeatures(vmi)
+				Expect(crs).To(HaveLen(len(expectedFeatures)))
+				for i, cr := range crs {
+					Expect(cr.Metric.GetOpts().Name).To(Equal("kubevirt_vmi_firmware_features"))
+					Expect(cr.Labels).To(Equal([]string{"test-ns", "test-vmi", expectedFeatures[i]}))
+					Expect(cr.Value).To(Equal(1.0))
+				}
+			},
+			Entry("without features", nil, nil),
+			Entry("with empty features", &k6tv1.Features{}, []string{"acpi"}),
+			Entry("with acpi disabled",
+				&k6tv1.Features{ACPI: k6tv1.FeatureState{Enabled: pointer.P(false)}},
+				nil),
+			Entry("with smm enabled",
+				&k6tv1.Features{SMM: &k6tv1.FeatureState{}},
+				[]string{"smm", "acpi"}),
+			Entry("with smm disabled",
+				&k6tv1.Features{SMM: &k6tv1.FeatureState{Enabled: pointer.P(false)}},
+				[]string{"acpi"}),
+			Entry("with hyperv enlightenments",
+				&k6tv1.Features{Hyperv: &k6tv1.FeatureHyperv{Relaxed: &k6tv1.FeatureState{}}},
+				[]string{"acpi", "hyperv"}),
+			Entry("with hyperv passthrough",
+				&k6tv1.Features{HypervPassthrough: &k6tv1.HyperVPassthrough{Enabled: pointer.P(true)}},
+				[]string{"acpi", "hyperv"}),
+		)
+	})
+
+	Context("VMI watchdog", func() {
+		DescribeTable("should collect kubevirt_vmi_watchdog metric",
+			func(watchdog *k6tv1.Watchdog, expectedAction string) {
+				vmi := &k6tv1.VirtualMachineInstance{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "test-ns",
+						Name:      "test-vmi",
+					},
+					Spec: k6tv1.VirtualMachineInstanceSpec{
+						Domain: k6tv1.DomainSpec{
+							Devices: k6tv1.Devices{
+								Watchdog: watchdog,
+							},
+						},
+					},
+				}
+
+				crs := collectVMIWatchdog(vmi)
+				if expectedAction == "" {
+					Expect(crs).To(BeEmpty())
+					return
+				}
+
+				Expect(crs).To(HaveLen(1))
+				Expect(crs[0].Metric.GetOpts().Name).To(Equal("kubevirt_vmi_watchdog"))
+				Expect(crs[0].Labels).To(Equal([]string{"test-ns", "test-vmi", expectedAction}))
+				Expect(crs[0].Value).To(Equal(1.0))
+			},
+			Entry("without a watchdog", nil, ""),
+			Entry("with an i6300esb watchdog without action",
+				&k6tv1.Watchdog{
+					Name:           "watchdog",
+					WatchdogDevice: k6tv1.WatchdogDevice{I6300ESB: &k6tv1.I6300ESBWatchdog{}},
+				},
+				"reset"),
+			Entry("with an i6300esb watchdog with poweroff action",
+				&k6tv1.Watchdog{
+					Name: "watchdog",
+					WatchdogDevice: k6tv1.WatchdogDevice{
+						I6300ESB: &k6tv1.I6300ESBWatchdog{Action: k6tv1.WatchdogActionPoweroff},
+					},
+				},
+				"poweroff"),
+			Entry("with a diag288 watchdog with shutdown action",
+				&k6tv1.Watchdog{
+					Name: "watchdog",
+					WatchdogDevice: k6tv1.WatchdogDevice{
+						Diag288: &k6tv1.Diag288Watchdog{Action: k6tv1.WatchdogActionShutdown},
+					},
+				},
+				"shutdown"),
+		)
+	})
+
+	Context("VMI launcher CPU overcommit", func() {
+		DescribeTable("should collect kubevirt_vmi_launcher_cpu_overcommit metric",
+			func(domain k6tv1.DomainSpec, expectedValue float64) {
+				vmi := &k6tv1.VirtualMachineInstance{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "test-ns",
+						Name:      "test-vmi",
+					},
+					Spec: k6tv1.VirtualMachineInstanceSpec{
+						Domain: domain,
+					},
+				}
+
+				metric := collectVMILauncherCPUOvercommit(vmi)
+				Expect(metric.Metric.GetOpts().Name).To(Equal("kubevirt_vmi_launcher_cpu_overcommit"))
+				Expect(metric.Labels).To(Equal([]string{"test-ns", "test-vmi"}))
+				Expect(metric.Value).To(Equal(expectedValue))
+			},
+			Entry("with the default cluster allocation ratio",
+				k6tv1.DomainSpec{},
+				float64(virtconfig.DefaultCPUAllocationRatio)),
+			Entry("with dedicated CPUs",
+				k6tv1.DomainSpec{CPU: &k6tv1.CPU{DedicatedCPUPlacement: true}},
+				1.0),
+			Entry("with an explicit CPU request",
+				k6tv1.DomainSpec{
+					Resources: k6tv1.ResourceRequirements{
+						Requests: k8sv1.ResourceList{k8sv1.ResourceCPU: resource.MustParse("2")},
+					},
+				},
+				1.0),
+		)
+	})
+
+	Context("VMI security profile", func() {
+		BeforeEach(func() {
+			originalKVPodIndexer := indexers.KVPod
+			DeferCleanup(func() {
+				indexers.KVPod = originalKVPodIndexer
+			})
+
+			kvPodInformer, _ := testutils.NewFakeInformerFor(&k8sv1.Pod{})
+			indexers.KVPod = kvPodInformer.GetIndexer()
+		})
+
+		newSecurityProfileTestVMI := func() *k6tv1.VirtualMachineInstance {
+			return &k6tv1.VirtualMachineInstance{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test-ns",
+					Name:      "test-vmi",
+					UID:       "test-vmi-uid",
+				},
+				Status: k6tv1.VirtualMachineInstanceStatus{
+					NodeName: "test-node",
+				},
+			}
+		}
+
+		addLauncherPod := func(podSecurityContext *k8sv1.PodSecurityContext, computeSecurityContext *k8sv1.SecurityContext) {
+			Expect(indexers.KVPod.Add(&k8sv1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test-ns",
+					Name:      "virt-launcher-test-vmi",
+					Labels:    map[string]string{"kubevirt.io/created-by": "test-vmi-uid"},
+				},
+				Spec: k8sv1.PodSpec{
+					NodeName:        "test-node",
+					SecurityContext: podSecurityContext,
+					Containers: []k8sv1.Container{{
+						Name:            "compute",
+						SecurityContext: computeSecurityContext,
+					}},
+				},
+				Status: k8sv1.PodStatus{
+					Phase: k8sv1.PodRunning,
+				},
+			})).To(Succeed())
+		}
+
+		It("should not collect kubevirt_vmi_security_profile metric for a VMI without a running pod", func() {
+			Expect(collectVMISecurityProfile(newSecurityProfileTestVMI())).To(BeEmpty())
+		})
+
+		DescribeTable("should collect kubevirt_vmi_security_profile metric",
+			func(podSecurityContext *k8sv1.PodSecurityContext, computeSecurityContext *k8sv1.SecurityContext, expectedProfiles []string) {
+				addLauncherPod(podSecurityContext, computeSecurityContext)
+
+				crs := collectVMISecurityProfile(newSecurityProfileTestVMI())
+				Expect(crs).To(HaveLen(len(expectedProfiles)))
+				for i, cr := range crs {
+					Expect(cr.Metric.GetOpts().Name).To(Equal("kubevirt_vmi_security_profile"))
+					Expect(cr.Labels).To(Equal([]string{"test-ns", "test-vmi", expectedProfiles[i]}))
+					Expect(cr.Value).To(Equal(1.0))
+				}
+			},
+			Entry("without security contexts", nil, nil, nil),
+			Entry("with an unconfined seccomp profile",
+				&k8sv1.PodSecurityContext{
+					SeccompProfile: &k8sv1.SeccompProfile{Type: k8sv1.SeccompProfileTypeUnconfined},
+				},
+				nil,
+				nil),
+			Entry("with a pod level seccomp profile",
+				&k8sv1.PodSecurityContext{
+					SeccompProfile: &k8sv1.SeccompProfile{Type: k8sv1.SeccompProfileTypeRuntimeDefault},
+				},
+				nil,
+				[]string{"seccomp"}),
+			Entry("with container level apparmor and selinux profiles",
+				nil,
+				&k8sv1.SecurityContext{
+					AppArmorProfile: &k8sv1.AppArmorProfile{Type: k8sv1.AppArmorProfileTypeRuntimeDefault},
+					SELinuxOptions:  &k8sv1.SELinuxOptions{Type: "container_t"},
+				},
+				[]string{"apparmor", "selinux"}),
+		)
+	})
+
+	Context("VMI disk error policy count", func() {
+		It("should not collect kubevirt_vmi_disk_error_policy_count metric for a VMI without disks", func() {
+			vmi := &k6tv1.VirtualMachineInstance{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test-ns",
+					Name:      "test-vmi",
+				},
+			}
+
+			Expect(collectVMIDiskErrorPolicyCount(vmi)).To(BeEmpty())
+		})
+
+		It("should count disks per error policy, defaulting to stop", func() {
+			vmi := &k6tv1.VirtualMachineInstance{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test-ns",
+					Name:      "test-vmi",
+				},
+				Spec: k6tv1.VirtualMachineInstanceSpec{
+					Domain: k6tv1.DomainSpec{
+						Devices: k6tv1.Devices{
+							Disks: []k6tv1.Disk{
+								{Name: "rootdisk"},
+								{Name: "datadisk", ErrorPolicy: pointer.P(k6tv1.DiskErrorPolicyReport)},
+								{Name: "scratch", ErrorPolicy: pointer.P(k6tv1.DiskErrorPolicyStop)},
+								{Name: "logs", ErrorPolicy: pointer.P(k6tv1.DiskErrorPolicyReport)},
+								{Name: "cache", ErrorPolicy: pointer.P(k6tv1.DiskErrorPolicyEnospace)},
+							},
+						},
+					},
+				},
+			}
+
+			crs := collectVMIDiskErrorPolicyCount(vmi)
+			Expect(crs).To(HaveLen(3))
+
+			expected := []struct {
+				policy string
+				count  float64
+			}{
+				{"stop", 2},
+				{"report", 2},
+				{"enospace", 1},
+			}
+			for i, cr := range crs {
+				Expect(cr.Metric.GetOpts().Name).To(Equal("kubevirt_vmi_disk_error_policy_count"))
+				Expect(cr.Labels).To(Equal([]string{"test-ns", "test-vmi", expected[i].policy}))
+				Expect(cr.Value).To(Equal(expected[i].count))
+			}
+		})
+	})
+
+	Context("VMI tolerations count", func() {
+		DescribeTable("should collect kubevirt_vmi_tolerations_count metric",
+			func(tolerations []k8sv1.Toleration, expectedValue float64) {
+				vmi := &k6tv1.VirtualMachineInstance{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "test-ns",
+						Name:      "test-vmi",
+					},
+					Spec: k6tv1.VirtualMachineInstanceSpec{
+						Tolerations: tolerations,
+					},
+				}
+
+				metric := collectVMITolerationsCount(vmi)
+				Expect(metric.Metric.GetOpts().Name).To(Equal("kubevirt_vmi_tolerations_count"))
+				Expect(metric.Labels).To(Equal([]string{"test-ns", "test-vmi"}))
+				Expect(metric.Value).To(Equal(expectedValue))
+			},
+			Entry("without tolerations", nil, 0.0),
+			Entry("with tolerations",
+				[]k8sv1.Toleration{
+					{Key: "dedicated", Operator: k8sv1.TolerationOpEqual, Value: "vms", Effect: k8sv1.TaintEffectNoSchedule},
+					{Key: "node.kubernetes.io/unreachable", Operator: k8sv1.TolerationOpExists, Effect: k8sv1.TaintEffectNoExecute},
+				},
+				2.0),
+		)
+	})
+
+	Context("VMI age", func() {
+		It("should not collect kubevirt_vmi_age_seconds metric without a creation timestamp", func() {
+			vmi := &k6tv1.VirtualMachineInstance{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test-ns",
+					Name:      "test-vmi",
+				},
+			}
+
+			Expect(collectVMIAge(vmi, time.Now())).To(BeEmpty())
+		})
+
+		It("should collect kubevirt_vmi_age_seconds metric as the time since creation", func() {
+			created := time.Date(2024, time.January, 1, 12, 0, 0, 0, time.UTC)
+			vmi := &k6tv1.VirtualMachineInstance{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:         "test-ns",
+					Name:              "test-vmi",
+					CreationTimestamp: metav1.NewTime(created),
+				},
+			}
+
+			crs := collectVMIAge(vmi, created.Add(time.Hour+30*time.Second))
+			Expect(crs).To(HaveLen(1))
+			Expect(crs[0].Metric.GetOpts().Name).To(Equal("kubevirt_vmi_age_seconds"))
+			Expect(crs[0].Labels).To(Equal([]string{"test-ns", "test-vmi"}))
+			Expect(crs[0].Value).To(Equal(3630.0))
+		})
+	})
+
+	Context("VMI sidecar count", func() {
+		DescribeTable("should collect kubevirt_vmi_sidecar_count metric",
+			func(annotations map[string]string, expectedValue float64) {
+				vmi := &k6tv1.VirtualMachineInstance{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace:   "test-ns",
+						Name:        "test-vmi",
+						Annotations: annotations,
+					},
+				}
+
+				crs := collectVMISidecarCount(vmi)
+				Expect(crs).To(HaveLen(1))
+				Expect(crs[0].Metric.GetOpts().Name).To(Equal("kubevirt_vmi_sidecar_count"))
+				Expect(crs[0].Labels).To(Equal([]string{"test-ns", "test-vmi"}))
+				Expect(crs[0].Value).To(Equal(expectedValue))
+			},
+			Entry("without hook sidecars", nil, 0.0),
+			Entry("with hook sidecars",
+				map[string]string{
+					hooks.HookSidecarListAnnotationName: `[{"image": "some-image:v1"}, {"image": "another-image:v1"}]`,
+				},
+				2.0),
+		)
+
+		It("should not collect kubevirt_vmi_sidecar_count metric for a malformed annotation", func() {
+			vmi := &k6tv1.VirtualMachineInstance{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test-ns",
+					Name:      "test-vmi",
+					Annotations: map[string]string{
+						hooks.HookSidecarListAnnotationName: "not-json",
+					},
+				},
+			}
+
+			Expect(collectVMISidecarCount(vmi)).To(BeEmpty())
+		})
+	})
+
+	Context("VMI node selector count", func() {
+		DescribeTable("should collect kubevirt_vmi_node_selector_count metric",
+			func(nodeSelector map[string]string, expectedValue float64) {
+				vmi := &k6tv1.VirtualMachineInstance{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "test-ns",
+						Name:      "test-vmi",
+					},
+					Spec: k6tv1.VirtualMachineInstanceSpec{
+						NodeSelector: nodeSelector,
+					},
+				}
+
+				metric := collectVMINodeSelectorCount(vmi)
+				Expect(metric.Metric.GetOpts().Name).To(Equal("kubevirt_vmi_node_selector_count"))
+				Expect(metric.Labels).To(Equal([]string{"test-ns", "test-vmi"}))
+				Expect(metric.Value).To(Equal(expectedValue))
+			},
+			Entry("without a node selector", nil, 0.0),
+			Entry("with a node selector",
+				map[string]string{
+					"kubernetes.io/arch":             "amd64",
+					"node-role.kubernetes.io/worker": "",
+				},
+				2.0),
+		)
+	})
+
+	Context("VMI ephemeral hotplug volume count", func() {
+		DescribeTable("should collect kubevirt_vmi_ephemeral_hotplug_volume_count metric",
+			func(annotations map[string]string, expectedValue float64) {
+				vmi := &k6tv1.VirtualMachineInstance{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace:   "test-ns",
+						Name:        "test-vmi",
+						Annotations: annotations,
+					},
+				}
+
+				crs := collectVMIEphemeralHotplugVolumeCount(vmi)
+				if expectedValue == 0 {
+					Expect(crs).To(BeEmpty())
+					return
+				}
+
+				Expect(crs).To(HaveLen(1))
+				Expect(crs[0].Metric.GetOpts().Name).To(Equal("kubevirt_vmi_ephemeral_hotplug_volume_count"))
+				Expect(crs[0].Labels).To(Equal([]string{"test-ns", "test-vmi"}))
+				Expect(crs[0].Value).To(Equal(expectedValue))
+			},
+			Entry("without ephemeral hotplug volumes", nil, 0.0),
+			Entry("with a single ephemeral hotplug volume",
+				map[string]string{k6tv1.EphemeralHotplugAnnotation: `["hotplug-1"]`},
+				1.0),
+			Entry("with several ephemeral hotplug volumes",
+				map[string]string{k6tv1.EphemeralHotplugAnnotation: `["hotplug-1","hotplug-2","hotplug-3"]`},
+				3.0),
+			Entry("with a malformed annotation",
+				map[string]string{k6tv1.EphemeralHotplugAnnotation: "hotplug-1"},
+				0.0),
+		)
+	})
+
+	Context("VMI ephemeral hotplug volume size", func() {
+		setupTestCollector()
+
+		BeforeEach(func() {
+			pvcInformer, _ := testutils.NewFakeInformerFor(&k8sv1.PersistentVolumeClaim{})
+			stores.PersistentVolumeClaim = pvcInformer.GetIndexer()
+
+			Expect(stores.PersistentVolumeClaim.Add(&k8sv1.PersistentVolumeClaim{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "test-ns", Name: "pvc-bound"},
+				Spec: k8sv1.PersistentVolumeClaimSpec{
+					StorageClassName: pointer.P("fast"),
+					Resources: k8sv1.VolumeResourceRequirements{
+						Requests: k8sv1.ResourceList{k8sv1.ResourceStorage: resource.MustParse("1Gi")},
+					},
+				},
+				Status: k8sv1.PersistentVolumeClaimStatus{
+					Capacity: k8sv1.ResourceList{k8sv1.ResourceStorage: resource.MustParse("2Gi")},
+				},
+			})).To(Succeed())
+			Expect(stores.PersistentVolumeClaim.Add(&k8sv1.PersistentVolumeClaim{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "test-ns", Name: "dv-pending"},
+				Spec: k8sv1.PersistentVolumeClaimSpec{
+					Resources: k8sv1.VolumeResourceRequirements{
+						Requests: k8sv1.ResourceList{k8sv1.ResourceStorage: resource.MustParse("3Gi")},
+					},
+				},
+			})).To(Succeed())
+		})
+
+		newVMI := func(ephemeralVolumes string) *k6tv1.VirtualMachineInstance {
+			return &k6tv1.VirtualMachineInstance{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:   "test-ns",
+					Name:        "test-vmi",
+					Annotations: map[string]string{k6tv1.EphemeralHotplugAnnotation: ephemeralVolumes},
+				},
+				Spec: k6tv1.VirtualMachineInstanceSpec{
+					Volumes: []k6tv1.Volume{
+						{Name: "hotplug-pvc", VolumeSource: k6tv1.VolumeSource{
+							PersistentVolumeClaim: &k6tv1.PersistentVolumeClaimVolumeSource{
+								PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: "pvc-bound"},
+								Hotpluggable:                      true,
+							},
+						}},
+						{Name: "hotplug-dv", VolumeSource: k6tv1.VolumeSource{
+							DataVolume: &k6tv1.DataVolumeSource{Name: "dv-pending", Hotpluggable: true},
+						}},
+						{Name: "hotplug-missing", VolumeSource: k6tv1.VolumeSource{
+							DataVolume: &k6tv1.DataVolumeSource{Name: "missing", Hotpluggable: true},
+						}},
+					},
+				},
+			}
+		}
+
+		It("should report the size, source and storage class of ephemeral hotplug volumes", func() {
+			crs := collectVMIEphemeralHotplugVolumeSize(newVMI(`["hotplug-pvc","hotplug-dv","hotplug-missing"]`))
+
+			Expect(crs).To(HaveLen(2))
+			Expect(crs[0].Metric.GetOpts().Name).To(Equal("kubevirt_vmi_ephemeral_hotplug_volume_size_bytes"))
+			Expect(crs[0].Labels).To(Equal([]string{"test-ns", "test-vmi", "hotplug-pvc", "pvc", "fast"}))
+			Expect(crs[0].Value).To(Equal(float64(2 * 1024 * 1024 * 1024)))
+			Expect(crs[1].Labels).To(Equal([]string{"test-ns", "test-vmi", "hotplug-dv", "datavolume", ""}))
+			Expect(crs[1].Value).To(Equal(float64(3 * 1024 * 1024 * 1024)))
+		})
+
+		It("should not report volumes that are not ephemeral", func() {
+			crs := collectVMIEphemeralHotplugVolumeSize(newVMI(`["hotplug-dv"]`))
+
+			Expect(crs).To(HaveLen(1))
+			Expect(crs[0].Labels[2]).To(Equal("hotplug-dv"))
+		})
+	})
+
+	Context("VMI backend storage", func() {
+		DescribeTable("should collect kubevirt_vmi_backend_storage metric",
+			func(volumeStatus []k6tv1.VolumeStatus, expectMetric bool) {
+				vmi := &k6tv1.VirtualMachineInstance{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "test-ns",
+						Name:      "test-vmi",
+					},
+					Status: k6tv1.VirtualMachineInstanceStatus{
+						VolumeStatus: volumeStatus,
+					},
+				}
+
+				crs := collectVMIBackendStorage(vmi)
+				if !expectMetric {
+					Expect(crs).To(BeEmpty())
+					return
+				}
+
+				Expect(crs).To(HaveLen(1))
+				Expect(crs[0].Metric.GetOpts().Name).To(Equal("kubevirt_vmi_backend_storage"))
+				Expect(crs[0].Labels).To(Equal([]string{"test-ns", "test-vmi"}))
+				Expect(crs[0].Value).To(Equal(1.0))
+			},
+			Entry("without volumes", nil, false),
+			Entry("with only regular volumes",
+				[]k6tv1.VolumeStatus{{
+					Name:                      "rootdisk",
+					PersistentVolumeClaimInfo: &k6tv1.PersistentVolumeClaimInfo{ClaimName: "rootdisk-pvc"},
+				}},
+				false),
+			Entry("with a backend storage volume",
+				[]k6tv1.VolumeStatus{
+					{
+						Name:                      "rootdisk",
+						PersistentVolumeClaimInfo: &k6tv1.PersistentVolumeClaimInfo{ClaimName: "rootdisk-pvc"},
+					},
+					{
+						Name:                      backendstorage.PVCPrefix + "-test-vmi",
+						PersistentVolumeClaimInfo: &k6tv1.PersistentVolumeClaimInfo{ClaimName: "persistent-state-for-test-vmi-abcde"},
+					},
+				},
+				true),
+		)
+	})
+
+	Context("VMI pinned vCPU count", func() {
+		DescribeTable("should collect kubevirt_vmi_pinned_vcpu_count metric",
+			func(cpu *k6tv1.CPU, expectedValue float64) {
+				vmi := &k6tv1.VirtualMachineInstance{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "test-ns",
+						Name:      "test-vmi",
+					},
+					Spec: k6tv1.VirtualMachineInstanceSpec{
+						Domain: k6tv1.DomainSpec{
+							CPU: cpu,
+						},
+					},
+				}
+
+				metric := collectVMIPinnedVCPUCount(vmi)
+				Expect(metric.Metric.GetOpts().Name).To(Equal("kubevirt_vmi_pinned_vcpu_count"))
+				Expect(metric.Labels).To(Equal([]string{"test-ns", "test-vmi"}))
+				Expect(metric.Value).To(Equal(expectedValue))
+			},
+			Entry("without a CPU spec", nil, 0.0),
+			Entry("without dedicated CPU placement",
+				&k6tv1.CPU{Sockets: 2, Cores: 2, Threads: 1},
+				0.0),
+			Entry("with dedicated CPU placement",
+				&k6tv1.CPU{Sockets: 2, Cores: 2, Threads: 2, DedicatedCPUPlacement: true},
+				8.0),
+		)
+	})
+
+	Context("VMI launcher CPU request", func() {
+		BeforeEach(func() {
+			originalKVPodIndexer := indexers.KVPod
+			DeferCleanup(func() {
+				indexers.KVPod = originalKVPodIndexer
+			})
+
+			kvPodInformer, _ := testutils.NewFakeInformerFor(&k8sv1.Pod{})
+			indexers.KVPod = kvPodInformer.GetIndexer()
+		})
+
+		newLauncherCPURequestTestVMI := func() *k6tv1.VirtualMachineInstance {
+			return &k6tv1.VirtualMachineInstance{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test-ns",
+					Name:      "test-vmi",
+					UID:       "test-vmi-uid",
+				},
+				Status: k6tv1.VirtualMachineInstanceStatus{
+					NodeName: "test-node",
+				},
+			}
+		}
+
+		It("should not collect kubevirt_vmi_launcher_cpu_request_millicores metric for a VMI without a running pod", func() {
+			Expect(collectVMILauncherCPURequest(newLauncherCPURequestTestVMI())).To(BeEmpty())
+		})
+
+		It("should sum the CPU requests of the launcher containers", func() {
+			Expect(indexers.KVPod.Add(&k8sv1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test-ns",
+					Name:      "virt-launcher-test-vmi",
+					Labels:    map[string]string{"kubevirt.io/created-by": "test-vmi-uid"},
+				},
+				Spec: k8sv1.PodSpec{
+					NodeName: "test-node",
+					Containers: []k8sv1.Container{
+						{
+							Name: "compute",
+							Resources: k8sv1.ResourceRequirements{
+								Requests: k8sv1.ResourceList{k8sv1.ResourceCPU: resource.MustParse("200m")},
+							},
+						},
+						{
+							Name: "hook-sidecar-0",
+							Resources: k8sv1.ResourceRequirements{
+								Requests: k8sv1.ResourceList{k8sv1.ResourceCPU: resource.MustParse("1")},
+							},
+						},
+						{
+							Name: "guest-console-log",
+						},
+					},
+				},
+				Status: k8sv1.PodStatus{
+					Phase: k8sv1.PodRunning,
+				},
+			})).To(Succeed())
+
+			crs := collectVMILauncherCPURequest(newLauncherCPURequestTestVMI())
+			Expect(crs).To(HaveLen(1))
+			Expect(crs[0].Metric.GetOpts().Name).To(Equal("kubevirt_vmi_launcher_cpu_request_millicores"))
+			Expect(crs[0].Labels).To(Equal([]string{"test-ns", "test-vmi"}))
+			Expect(crs[0].Value).To(Equal(1200.0))
+		})
+	})
+
+	Context("VMI GPU display enabled", func() {
+		DescribeTable("should collect kubevirt_vmi_gpu_display_enabled metric",
+			func(gpus []k6tv1.GPU, expectMetric bool) {
+				vmi := &k6tv1.VirtualMachineInstance{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "test-ns",
+						Name:      "test-vmi",
+					},
+					Spec: k6tv1.VirtualMachineInstanceSpec{
+						Domain: k6tv1.DomainSpec{
+							Devices: k6tv1.Devices{
+								GPUs: gpus,
+							},
+						},
+					},
+				}
+
+				crs := collectVMIGPUDisplayEnabled(vmi)
+				if !expectMetric {
+					Expect(crs).To(BeEmpty())
+					return
+				}
+
+				Expect(crs).To(HaveLen(1))
+				Expect(crs[0].Metric.GetOpts().Name).To(Equal("kubevirt_vmi_gpu_display_enabled"))
+				Expect(crs[0].Labels).To(Equal([]string{"test-ns", "test-vmi"}))
+				Expect(crs[0].Value).To(Equal(1.0))
+			},
+			Entry("without GPUs", nil, false),
+			Entry("with a compute GPU", []k6tv1.GPU{{Name: "gpu1", DeviceName: "nvidia.com/A100"}}, false),
+			Entry("with a disabled display",
+				[]k6tv1.GPU{{
+					Name:       "gpu1",
+					DeviceName: "nvidia.com/GRID_T4-1Q",
+					VirtualGPUOptions: &k6tv1.VGPUOptions{
+						Display: &k6tv1.VGPUDisplayOptions{Enabled: pointer.P(false)},
+					},
+				}},
+				false),
+			Entry("with a display using the default enablement",
+				[]k6tv1.GPU{
+					{Name: "gpu1", DeviceName: "nvidia.com/A100"},
+					{
+						Name:       "gpu2",
+						DeviceName: "nvidia.com/GRID_T4-1Q",
+						VirtualGPUOptions: &k6tv1.VGPUOptions{
+							Display: &k6tv1.VGPUDisplayOptions{},
+						},
+					},
+				},
+				true),
+		)
+	})
+
+	Context("VMI disk dedicated IO thread count", func() {
+		DescribeTable("should collect kubevirt_vmi_disk_dedicated_iothread_count metric",
+			func(disks []k6tv1.Disk, expectedValue float64) {
+				vmi := &k6tv1.VirtualMachineInstance{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "test-ns",
+						Name:      "test-vmi",
+					},
+					Spec: k6tv1.VirtualMachineInstanceSpec{
+						Domain: k6tv1.DomainSpec{
+							Devices: k6tv1.Devices{
+								Disks: disks,
+							},
+						},
+					},
+				}
+
+				metric := collectVMIDiskDedicatedIOThreadCount(vmi)
+				Expect(metric.Metric.GetOpts().Name).To(Equal("kubevirt_vmi_disk_dedicated_iothread_count"))
+				Expect(metric.Labels).To(Equal([]string{"test-ns", "test-vmi"}))
+				Expect(metric.Value).To(Equal(expectedValue))
+			},
+			Entry("without disks", nil, 0.0),
+			Entry("without dedicated IO threads",
+				[]k6tv1.Disk{{Name: "disk0"}, {Name: "disk1", DedicatedIOThread: pointer.P(false)}},
+				0.0),
+			Entry("with dedicated IO threads",
+				[]k6tv1.Disk{
+					{Name: "disk0", DedicatedIOThread: pointer.P(true)},
+					{Name: "disk1"},
+					{Name: "disk2", DedicatedIOThread: pointer.P(true)},
+				},
+				2.0),
+		)
+	})
+
+	Context("VMI memory overcommit factor", func() {
+		newVMI := func(requests, limits k8sv1.ResourceList) *k6tv1.VirtualMachineInstance {
+			overhead := resource.MustParse("256Mi")
+			return &k6tv1.VirtualMachineInstance{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test-ns",
+					Name:      "test-vmi",
+				},
+				Spec: k6tv1.VirtualMachineInstanceSpec{
+					Domain: k6tv1.DomainSpec{
+						Resources: k6tv1.ResourceRequirements{
+							Requests: requests,
+							Limits:   limits,
+						},
+					},
+				},
+				Status: k6tv1.VirtualMachineInstanceStatus{
+					Memory: &k6tv1.MemoryStatus{MemoryOverhead: &overhead},
+				},
+			}
+		}
+
+		DescribeTable("should collect kubevirt_vmi_memory_overcommit_factor metric",
+			func(requests, limits k8sv1.ResourceList, expectedValue float64) {
+				crs := collectVMIMemoryOvercommitFactor(newVMI(requests, limits))
+				Expect(crs).To(HaveLen(1))
+				Expect(crs[0].Metric.GetOpts().Name).To(Equal("kubevirt_vmi_memory_overcommit_factor"))
+				Expect(crs[0].Labels).To(Equal([]string{"test-ns", "test-vmi"}))
+				Expect(crs[0].Value).To(Equal(expectedValue))
+			},
+			Entry("when request and overhead fit the limit",
+				k8sv1.ResourceList{k8sv1.ResourceMemory: resource.MustParse("768Mi")},
+				k8sv1.ResourceList{k8sv1.ResourceMemory: resource.MustParse("2Gi")},
+				0.5),
+			Entry("when request and overhead exceed the limit",
+				k8sv1.ResourceList{k8sv1.ResourceMemory: resource.MustParse("1792Mi")},
+				k8sv1.ResourceList{k8sv1.ResourceMemory: resource.MustParse("1Gi")},
+				2.0),
+			Entry("when no memory request is set",
+				nil,
+				k8sv1.ResourceList{k8sv1.ResourceMemory: resource.MustParse("1Gi")},
+				0.25),
+		)
+
+		DescribeTable("should not collect kubevirt_vmi_memory_overcommit_factor metric",
+			func(limits k8sv1.ResourceList) {
+				requests := k8sv1.ResourceList{k8sv1.ResourceMemory: resource.MustParse("1Gi")}
+				Expect(collectVMIMemoryOvercommitFactor(newVMI(requests, limits))).To(BeEmpty())
+			},
+			Entry("when no memory limit is set", nil),
+			Entry("when the memory limit is zero", k8sv1.ResourceList{k8sv1.ResourceMemory: resource.MustParse("0")}),
+		)
+	})
+
+	Context("VMI migration duration", func() {
+		start := metav1.Unix(1000, 0)
+		end := metav1.Unix(1042, 0)
+
+		newVMI := func(migrationState *k6tv1.VirtualMachineInstanceMigrationState) *k6tv1.VirtualMachineInstance {
+			return &k6tv1.VirtualMachineInstance{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test-ns",
+					Name:      "testvmi",
+				},
+				Status: k6tv1.VirtualMachineInstanceStatus{
+					NodeName:       "testNode",
+					MigrationState: migrationState,
+				},
+			}
+		}
+
+		DescribeTable("should collect kubevirt_vmi_migration_duration_seconds metric",
+			func(failed bool, expectedStatus string) {
+				crs := collectVMIMigrationDuration(newVMI(&k6tv1.VirtualMachineInstanceMigrationState{
+					MigrationUID:   "test-migration-uid",
+					StartTimestamp: &start,
+					EndTimestamp:   &end,
+					Completed:      true,
+					Failed:         failed,
+				}))
+				Expect(crs).To(HaveLen(1))
+				Expect(crs[0].Metric.GetOpts().Name).To(Equal("kubevirt_vmi_migration_duration_seconds"))
+				Expect(crs[0].Labels).To(Equal([]string{"testNode", "test-ns", "testvmi", "test-migration", expectedStatus}))
+				Expect(crs[0].Value).To(Equal(42.0))
+			},
+			Entry("for a succeeded migration", false, "succeeded"),
+			Entry("for a failed migration", true, "failed"),
+		)
+
+		DescribeTable("should not collect kubevirt_vmi_migration_duration_seconds metric",
+			func(migrationState *k6tv1.VirtualMachineInstanceMigrationState) {
+				Expect(collectVMIMigrationDuration(newVMI(migrationState))).To(BeEmpty())
+			},
+			Entry("without a migration state", nil),
+			Entry("for a migration in progress", &k6tv1.VirtualMachineInstanceMigrationState{StartTimestamp: &start}),
+		)
+	})
+
+	Context("VMI memory dump duration", func() {
+		start := metav1.Unix(1000, 0)
+		end := metav1.Unix(1090, 0)
+
+		newVMI := func(phase k6tv1.VolumePhase, memoryDump *k6tv1.DomainMemoryDumpInfo) *k6tv1.VirtualMachineInstance {
+			return &k6tv1.VirtualMachineInstance{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test-ns",
+					Name:      "test-vmi",
+				},
+				Status: k6tv1.VirtualMachineInstanceStatus{
+					VolumeStatus: []k6tv1.VolumeStatus{
+						{Name: "rootdisk", Phase: k6tv1.VolumeReady},
+						{Name: "dump-pvc", Phase: phase, MemoryDumpVolume: memoryDump},
+					},
+				},
+			}
+		}
+
+		DescribeTable("should collect kubevirt_vmi_memory_dump_duration_seconds metric",
+			func(phase k6tv1.VolumePhase, expectedPhase string) {
+				crs := collectVMIMemoryDumpDuration(newVMI(phase, &k6tv1.DomainMemoryDumpInfo{
+					ClaimName:      "dump-pvc",
+					StartTimestamp: &start,
+					EndTimestamp:   &end,
+				}))
+				Expect(crs).To(HaveLen(1))
+				Expect(crs[0].Metric.GetOpts().Name).To(Equal("kubevirt_vmi_memory_dump_duration_seconds"))
+				Expect(crs[0].Labels).To(Equal([]string{"test-ns", "test-vmi", "dump-pvc", expectedPhase}))
+				Expect(crs[0].Value).To(Equal(90.0))
+			},
+			Entry("for a completed memory dump", k6tv1.MemoryDumpVolumeCompleted, "Completed"),
+			Entry("for a failed memory dump", k6tv1.MemoryDumpVolumeFailed, "Failed"),
+		)
+
+		It("should report the elapsed time of a memory dump in progress", func() {
+			startedAt := metav1.NewTime(time.Now().Add(-time.Minute))
+			crs := collectVMIMemoryDumpDuration(newVMI(k6tv1.MemoryDumpVolumeInProgress, &k6tv1.DomainMemoryDumpInfo{
+				ClaimName:      "dump-pvc",
+				StartTimestamp: &startedAt,
+			}))
+			Expect(crs).To(HaveLen(1))
+			Expect(crs[0].Labels).To(Equal([]string{"test-ns", "test-vmi", "dump-pvc", "InProgress"}))
+			Expect(crs[0].Value).To(BeNumerically(">=", 60.0))
+		})
+
+		DescribeTable("should not collect kubevirt_vmi_memory_dump_duration_seconds metric",
+			func(phase k6tv1.VolumePhase, memoryDump *k6tv1.DomainMemoryDumpInfo) {
+				Expect(collectVMIMemoryDumpDuration(newVMI(phase, memoryDump))).To(BeEmpty())
+			},
+			Entry("without a memory dump volume", k6tv1.VolumeReady, nil),
+			Entry("before the memory dump starts", k6tv1.VolumePending, &k6tv1.DomainMemoryDumpInfo{ClaimName: "dump-pvc"}),
+		)
 	})
 })
 
@@ -24,20 +24,49 @@ import (
 
 	io_prometheus_client "github.com/prometheus/client_model/go"
 	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+
+	"kubevirt.io/kubevirt/pkg/monitoring/metrics/common/catalog"
 )
 
 var (
 	vmSnapshotMetrics = []operatormetrics.Metric{
 		VMSnapshotSucceededTimestamp,
+		vmSnapshotDuration,
+		vmSnapshotFailed,
+		vmRestoreDuration,
 	}
 
-	VMSnapshotSucceededTimestamp = operatormetrics.NewGaugeVec(
+	VMSnapshotSucceededTimestamp = catalog.NewGaugeVec(
 		operatormetrics.MetricOpts{
 			Name: "kubevirt_vmsnapshot_succeeded_timestamp_seconds",
 			Help: "Returns the timestamp of successful virtual machine snapshot.",
 		},
 		[]string{"name", "snapshot_name", "namespace"},
 	)
+
+	vmSnapshotDuration = catalog.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmsnapshot_duration_seconds",
+			Help: "Returns the time it took for a virtual machine snapshot to succeed, from its creation.",
+		},
+		[]string{"name", "snapshot_name", "namespace"},
+	)
+
+	vmSnapshotFailed = catalog.NewCounterVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmsnapshot_failed_total",
+			Help: "Total number of failed virtual machine snapshots.",
+		},
+		[]string{"name", "namespace", "reason"},
+	)
+
+	vmRestoreDuration = catalog.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmrestore_duration_seconds",
+			Help: "Returns the time it took for a virtual machine restore to complete, from its creation.",
+		},
+		[]string{"name", "restore_name", "namespace"},
+	)
 )
 
 func HandleSucceededVMSnapshot(snapshot *snapshotv1.VirtualMachineSnapshot) {
@@ -47,9 +76,35 @@ func HandleSucceededVMSnapshot(snapshot *snapshotv1.VirtualMachineSnapshot) {
 			snapshot.Name,
 			snapshot.Namespace,
 		).Set(float64(snapshot.Status.CreationTime.Unix()))
+
+		vmSnapshotDuration.WithLabelValues(
+			snapshot.Spec.Source.Name,
+			snapshot.Name,
+			snapshot.Namespace,
+		).Set(snapshot.Status.CreationTime.Sub(snapshot.CreationTimestamp.Time).Seconds())
 	}
 }
 
+func HandleFailedVMSnapshot(snapshot *snapshotv1.VirtualMachineSnapshot, reason string) {
+	vmSnapshotFailed.WithLabelValues(
+		snapshot.Spec.Source.Name,
+		snapshot.Namespace,
+		reason,
+	).Inc()
+}
+
+func HandleCompletedVMRestore(restore *snapshotv1.VirtualMachineRestore) {
+	if restore.Status == nil || restore.Status.RestoreTime == nil {
+		return
+	}
+
+	vmRestoreDuration.WithLabelValues(
+		restore.Spec.Target.Name,
+		restore.Name,
+		restore.Namespace,
+	).Set(restore.Status.RestoreTime.Sub(restore.CreationTimestamp.Time).Seconds())
+}
+
 func GetVMSnapshotSucceededTimestamp(vm, snapshot, namespace string) (float64, error) {
 	dto := &io_prometheus_client.Metric{}
 	if err := VMSnapshotSucceededTimestamp.WithLabelValues(vm, snapshot, namespace).Write(dto); err != nil {
@@ -57,3 +112,27 @@ func GetVMSnapshotSucceededTimestamp(vm, snapshot, namespace string) (float64, e
 	}
 	return *dto.Gauge.Value, nil
 }
+
+func GetVMSnapshotDuration(vm, snapshot, namespace string) (float64, error) {
+	dto := &io_prometheus_client.Metric{}
+	if err := vmSnapshotDuration.WithLabelValues(vm, snapshot, namespace).Write(dto); err != nil {
+		return 0, err
+	}
+	return *dto.Gauge.Value, nil
+}
+
+func GetVMSnapshotFailedCount(vm, namespace, reason string) (float64, error) {
+	dto := &io_prometheus_client.Metric{}
+	if err := vmSnapshotFailed.WithLabelValues(vm, namespace, reason).Write(dto); err != nil {
+		return 0, err
+	}
+	return *dto.Counter.Value, nil
+}
+
+func GetVMRestoreDuration(vm, restore, namespace string) (float64, error) {
+	dto := &io_prometheus_client.Metric{}
+	if err := vmRestoreDuration.WithLabelValues(vm, restore, namespace).Write(dto); err != nil {
+		return 0, err
+	}
+	return *dto.Gauge.Value, nil
+}
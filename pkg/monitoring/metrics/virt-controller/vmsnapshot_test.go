@@ -65,5 +65,88 @@ var _ = Describe("VMSnapshot Metrics Collector", func() {
 
 			Expect(metricTime).To(Equal(float64(vmSnapshot.Status.CreationTime.Unix())))
 		})
+
+		It("should set the VMSnapshot duration metric", func() {
+			creationTimestamp := metav1.Unix(1000, 0)
+			creationTime := metav1.Unix(1030, 0)
+			vmSnapshot := &snapshotv1.VirtualMachineSnapshot{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "snapshot-name",
+					Namespace:         "namespace",
+					CreationTimestamp: creationTimestamp,
+				},
+				Spec: snapshotv1.VirtualMachineSnapshotSpec{
+					Source: corev1.TypedLocalObjectReference{
+						APIGroup: pointer.P("kubevirt.io"),
+						Kind:     "VirtualMachine",
+						Name:     "vm-name",
+					},
+				},
+				Status: &snapshotv1.VirtualMachineSnapshotStatus{
+					CreationTime: &creationTime,
+					ReadyToUse:   pointer.P(true),
+					Phase:        snapshotv1.Succeeded,
+				},
+			}
+
+			metrics.HandleSucceededVMSnapshot(vmSnapshot)
+
+			duration, err := metrics.GetVMSnapshotDuration("vm-name", "snapshot-name", "namespace")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(duration).To(Equal(30.0))
+		})
+
+		It("should count failed VMSnapshots per VM and reason", func() {
+			vmSnapshot := &snapshotv1.VirtualMachineSnapshot{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "failed-snapshot",
+					Namespace: "namespace",
+				},
+				Spec: snapshotv1.VirtualMachineSnapshotSpec{
+					Source: corev1.TypedLocalObjectReference{
+						APIGroup: pointer.P("kubevirt.io"),
+						Kind:     "VirtualMachine",
+						Name:     "failing-vm",
+					},
+				},
+			}
+
+			metrics.HandleFailedVMSnapshot(vmSnapshot, "DeadlineExceeded")
+			metrics.HandleFailedVMSnapshot(vmSnapshot, "DeadlineExceeded")
+
+			count, err := metrics.GetVMSnapshotFailedCount("failing-vm", "namespace", "DeadlineExceeded")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(count).To(Equal(2.0))
+		})
+	})
+
+	Context("VMRestore status collector", func() {
+		It("should set the VMRestore duration metric", func() {
+			restoreTime := metav1.Unix(1045, 0)
+			vmRestore := &snapshotv1.VirtualMachineRestore{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "restore-name",
+					Namespace:         "namespace",
+					CreationTimestamp: metav1.Unix(1000, 0),
+				},
+				Spec: snapshotv1.VirtualMachineRestoreSpec{
+					Target: corev1.TypedLocalObjectReference{
+						APIGroup: pointer.P("kubevirt.io"),
+						Kind:     "VirtualMachine",
+						Name:     "vm-name",
+					},
+				},
+				Status: &snapshotv1.VirtualMachineRestoreStatus{
+					Complete:    pointer.P(true),
+					RestoreTime: &restoreTime,
+				},
+			}
+
+			metrics.HandleCompletedVMRestore(vmRestore)
+
+			duration, err := metrics.GetVMRestoreDuration("vm-name", "restore-name", "namespace")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(duration).To(Equal(45.0))
+		})
 	})
 })
@@ -33,6 +33,7 @@ import (
 	"kubevirt.io/client-go/log"
 
 	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/monitoring/metrics/common/catalog"
 	vmlabels "kubevirt.io/kubevirt/pkg/monitoring/metrics/common/labels"
 	"kubevirt.io/kubevirt/pkg/util/hardware"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter/vcpu"
@@ -44,7 +45,7 @@ var (
 			vmResourceRequests, vmResourceLimits, vmInfo,
 			vmDiskAllocatedSize, vmCreationTimestamp, vmVnicInfo, vmLabels,
 		),
-		CollectCallback: vmStatsCollectorCallback,
+		CollectCallback: whenCachesSynced(vmStatsCollectorCallback),
 	}
 
 	invalidLabelCharRE = regexp.MustCompile(`[^a-zA-Z0-9_]`)
@@ -60,7 +61,7 @@ var (
 		errorTimestamp,
 	}
 
-	startingTimestamp = operatormetrics.NewCounterVec(
+	startingTimestamp = catalog.NewCounterVec(
 		operatormetrics.MetricOpts{
 			Name: "kubevirt_vm_starting_status_last_transition_timestamp_seconds",
 			Help: "Virtual Machine last transition timestamp to starting status.",
@@ -68,7 +69,7 @@ var (
 		labels,
 	)
 
-	runningTimestamp = operatormetrics.NewCounterVec(
+	runningTimestamp = catalog.NewCounterVec(
 		operatormetrics.MetricOpts{
 			Name: "kubevirt_vm_running_status_last_transition_timestamp_seconds",
 			Help: "Virtual Machine last transition timestamp to running status.",
@@ -76,7 +77,7 @@ var (
 		labels,
 	)
 
-	migratingTimestamp = operatormetrics.NewCounterVec(
+	migratingTimestamp = catalog.NewCounterVec(
 		operatormetrics.MetricOpts{
 			Name: "kubevirt_vm_migrating_status_last_transition_timestamp_seconds",
 			Help: "Virtual Machine last transition timestamp to migrating status.",
@@ -84,7 +85,7 @@ var (
 		labels,
 	)
 
-	nonRunningTimestamp = operatormetrics.NewCounterVec(
+	nonRunningTimestamp = catalog.NewCounterVec(
 		operatormetrics.MetricOpts{
 			Name: "kubevirt_vm_non_running_status_last_transition_timestamp_seconds",
 			Help: "Virtual Machine last transition timestamp to paused/stopped status.",
@@ -92,7 +93,7 @@ var (
 		labels,
 	)
 
-	errorTimestamp = operatormetrics.NewCounterVec(
+	errorTimestamp = catalog.NewCounterVec(
 		operatormetrics.MetricOpts{
 			Name: "kubevirt_vm_error_status_last_transition_timestamp_seconds",
 			Help: "Virtual Machine last transition timestamp to error status.",
@@ -133,7 +134,7 @@ var (
 		k6tv1.VirtualMachineStatusDataVolumeError,
 	}
 
-	vmResourceRequests = operatormetrics.NewGaugeVec(
+	vmResourceRequests = catalog.NewGaugeVec(
 		operatormetrics.MetricOpts{
 			Name: "kubevirt_vm_resource_requests",
 			Help: "Resources requested by Virtual Machine. Reports memory and CPU requests.",
@@ -141,7 +142,7 @@ var (
 		[]string{"name", "namespace", "resource", "unit", "source"},
 	)
 
-	vmResourceLimits = operatormetrics.NewGaugeVec(
+	vmResourceLimits = catalog.NewGaugeVec(
 		operatormetrics.MetricOpts{
 			Name: "kubevirt_vm_resource_limits",
 			Help: "Resource limits set for a Virtual Machine. Reports CPU and memory limits only when they are defined.",
@@ -149,7 +150,7 @@ var (
 		[]string{"name", "namespace", "resource", "unit"},
 	)
 
-	vmInfo = operatormetrics.NewGaugeVec(
+	vmInfo = catalog.NewGaugeVec(
 		operatormetrics.MetricOpts{
 			Name: "kubevirt_vm_info",
 			Help: "Information about Virtual Machines.",
@@ -172,7 +173,7 @@ var (
 		},
 	)
 
-	vmDiskAllocatedSize = operatormetrics.NewGaugeVec(
+	vmDiskAllocatedSize = catalog.NewGaugeVec(
 		operatormetrics.MetricOpts{
 			Name: "kubevirt_vm_disk_allocated_size_bytes",
 			Help: "Allocated disk size of a Virtual Machine in bytes, based on its PersistentVolumeClaim. " +
@@ -182,7 +183,7 @@ var (
 		[]string{"name", "namespace", "persistentvolumeclaim", "volume_mode", "device"},
 	)
 
-	vmCreationTimestamp = operatormetrics.NewGaugeVec(
+	vmCreationTimestamp = catalog.NewGaugeVec(
 		operatormetrics.MetricOpts{
 			Name: "kubevirt_vm_create_date_timestamp_seconds",
 			Help: "Virtual Machine creation timestamp.",
@@ -190,7 +191,7 @@ var (
 		[]string{"name", "namespace"},
 	)
 
-	vmVnicInfo = operatormetrics.NewGaugeVec(
+	vmVnicInfo = catalog.NewGaugeVec(
 		operatormetrics.MetricOpts{
 			Name: "kubevirt_vm_vnic_info",
 			Help: "Details of Virtual Machine (VM) vNIC interfaces, such as vNIC name, binding type, network name, " +
@@ -199,7 +200,7 @@ var (
 		[]string{"name", "namespace", "vnic_name", "binding_type", "network", "binding_name", "model"},
 	)
 
-	vmLabels = operatormetrics.NewGaugeVec(
+	vmLabels = catalog.NewGaugeVec(
 		operatormetrics.MetricOpts{
 			Name: "kubevirt_vm_labels",
 			Help: "The metric exposes the VM labels as Prometheus labels. " +
@@ -761,7 +762,7 @@ func CollectVmsVnicInfo(vms []*k6tv1.VirtualMachine) []operatormetrics.Collector
 		networks := vm.Spec.Template.Spec.Networks
 
 		for _, iface := range interfaces {
-			model := modelNone
+			model := valueNone
 			if iface.Model != "" {
 				model = iface.Model
 			}
@@ -22,63 +22,113 @@ package domainstats
 import (
 	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
 	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/monitoring/metrics/common/catalog"
 )
 
 var (
 	storageIopsRead = operatormetrics.NewCounter(
-		operatormetrics.MetricOpts{
+		catalog.Opts(operatormetrics.MetricOpts{
 			Name: "kubevirt_vmi_storage_iops_read_total",
 			Help: "Total number of I/O read operations.",
 		},
+			catalog.WithStabilityLevel(catalog.Stable),
+			catalog.WithLabelDescription("node", "Node the VMI is running on."),
+			catalog.WithLabelDescription("namespace", "Namespace of the VMI."),
+			catalog.WithLabelDescription("name", "Name of the VMI."),
+			catalog.WithLabelDescription("drive", "Name of the VMI disk."),
+		),
 	)
 
 	storageIopsWrite = operatormetrics.NewCounter(
-		operatormetrics.MetricOpts{
+		catalog.Opts(operatormetrics.MetricOpts{
 			Name: "kubevirt_vmi_storage_iops_write_total",
 			Help: "Total number of I/O write operations.",
 		},
+			catalog.WithStabilityLevel(catalog.Stable),
+			catalog.WithLabelDescription("node", "Node the VMI is running on."),
+			catalog.WithLabelDescription("namespace", "Namespace of the VMI."),
+			catalog.WithLabelDescription("name", "Name of the VMI."),
+			catalog.WithLabelDescription("drive", "Name of the VMI disk."),
+		),
 	)
 
 	storageReadTrafficBytes = operatormetrics.NewCounter(
-		operatormetrics.MetricOpts{
+		catalog.Opts(operatormetrics.MetricOpts{
 			Name: "kubevirt_vmi_storage_read_traffic_bytes_total",
 			Help: "Total number of bytes read from storage.",
 		},
+			catalog.WithStabilityLevel(catalog.Stable),
+			catalog.WithLabelDescription("node", "Node the VMI is running on."),
+			catalog.WithLabelDescription("namespace", "Namespace of the VMI."),
+			catalog.WithLabelDescription("name", "Name of the VMI."),
+			catalog.WithLabelDescription("drive", "Name of the VMI disk."),
+		),
 	)
 
 	storageWriteTrafficBytes = operatormetrics.NewCounter(
-		operatormetrics.MetricOpts{
+		catalog.Opts(operatormetrics.MetricOpts{
 			Name: "kubevirt_vmi_storage_write_traffic_bytes_total",
 			Help: "Total number of written bytes.",
 		},
+			catalog.WithStabilityLevel(catalog.Stable),
+			catalog.WithLabelDescription("node", "Node the VMI is running on."),
+			catalog.WithLabelDescription("namespace", "Namespace of the VMI."),
+			catalog.WithLabelDescription("name", "Name of the VMI."),
+			catalog.WithLabelDescription("drive", "Name of the VMI disk."),
+		),
 	)
 
 	storageReadTimesSeconds = operatormetrics.NewCounter(
-		operatormetrics.MetricOpts{
+		catalog.Opts(operatormetrics.MetricOpts{
 			Name: "kubevirt_vmi_storage_read_times_seconds_total",
 			Help: "Total time spent on read operations.",
 		},
+			catalog.WithStabilityLevel(catalog.Stable),
+			catalog.WithLabelDescription("node", "Node the VMI is running on."),
+			catalog.WithLabelDescription("namespace", "Namespace of the VMI."),
+			catalog.WithLabelDescription("name", "Name of the VMI."),
+			catalog.WithLabelDescription("drive", "Name of the VMI disk."),
+		),
 	)
 
 	storageWriteTimesSeconds = operatormetrics.NewCounter(
-		operatormetrics.MetricOpts{
+		catalog.Opts(operatormetrics.MetricOpts{
 			Name: "kubevirt_vmi_storage_write_times_seconds_total",
 			Help: "Total time spent on write operations.",
 		},
+			catalog.WithStabilityLevel(catalog.Stable),
+			catalog.WithLabelDescription("node", "Node the VMI is running on."),
+			catalog.WithLabelDescription("namespace", "Namespace of the VMI."),
+			catalog.WithLabelDescription("name", "Name of the VMI."),
+			catalog.WithLabelDescription("drive", "Name of the VMI disk."),
+		),
 	)
 
 	storageFlushRequests = operatormetrics.NewCounter(
-		operatormetrics.MetricOpts{
+		catalog.Opts(operatormetrics.MetricOpts{
 			Name: "kubevirt_vmi_storage_flush_requests_total",
 			Help: "Total storage flush requests.",
 		},
+			catalog.WithStabilityLevel(catalog.Stable),
+			catalog.WithLabelDescription("node", "Node the VMI is running on."),
+			catalog.WithLabelDescription("namespace", "Namespace of the VMI."),
+			catalog.WithLabelDescription("name", "Name of the VMI."),
+			catalog.WithLabelDescription("drive", "Name of the VMI disk."),
+		),
 	)
 
 	storageFlushTimesSeconds = operatormetrics.NewCounter(
-		operatormetrics.MetricOpts{
+		catalog.Opts(operatormetrics.MetricOpts{
 			Name: "kubevirt_vmi_storage_flush_times_seconds_total",
 			Help: "Total time spent on cache flushing.",
 		},
+			catalog.WithStabilityLevel(catalog.Stable),
+			catalog.WithLabelDescription("node", "Node the VMI is running on."),
+			catalog.WithLabelDescription("namespace", "Namespace of the VMI."),
+			catalog.WithLabelDescription("name", "Name of the VMI."),
+			catalog.WithLabelDescription("drive", "Name of the VMI disk."),
+		),
 	)
 )
 
@@ -42,10 +42,10 @@ var (
 		networkMetrics{},
 		cpuAffinityMetrics{},
 		filesystemMetrics{},
+		usersMetrics{},
 	}
 
 	Collector = operatormetrics.Collector{
-		Metrics:         domainStatsMetrics(domainStatsResourceMetrics...),
 		CollectCallback: domainStatsCollectorCallback,
 	}
 
@@ -67,6 +67,10 @@ func SetupDomainStatsCollector(maxRequestsInFlight int, vmiInformer cache.Shared
 		maxRequestsInFlight: maxRequestsInFlight,
 		vmiInformer:         vmiInformer,
 	}
+
+	// The metrics are declared across the files of the package and are not dependencies of
+	// Collector, so they are only listed after the package variables are all initialized
+	Collector.Metrics = domainStatsMetrics(domainStatsResourceMetrics...)
 }
 
 func domainStatsMetrics(rms ...resourceMetrics) []operatormetrics.Metric {
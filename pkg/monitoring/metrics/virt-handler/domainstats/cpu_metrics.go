@@ -22,55 +22,99 @@ package domainstats
 import (
 	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
 	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/monitoring/metrics/common/catalog"
 )
 
 var (
 	cpuUsageSeconds = operatormetrics.NewCounter(
-		operatormetrics.MetricOpts{
+		catalog.Opts(operatormetrics.MetricOpts{
 			Name: "kubevirt_vmi_cpu_usage_seconds_total",
 			Help: "Total CPU time spent in all modes (sum of both vcpu and hypervisor usage).",
 		},
+			catalog.WithStabilityLevel(catalog.Stable),
+			catalog.WithLabelDescription("node", "Node the VMI is running on."),
+			catalog.WithLabelDescription("namespace", "Namespace of the VMI."),
+			catalog.WithLabelDescription("name", "Name of the VMI."),
+		),
 	)
 
 	cpuUserUsageSeconds = operatormetrics.NewCounter(
-		operatormetrics.MetricOpts{
+		catalog.Opts(operatormetrics.MetricOpts{
 			Name: "kubevirt_vmi_cpu_user_usage_seconds_total",
 			Help: "Total CPU time spent in user mode.",
 		},
+			catalog.WithStabilityLevel(catalog.Stable),
+			catalog.WithLabelDescription("node", "Node the VMI is running on."),
+			catalog.WithLabelDescription("namespace", "Namespace of the VMI."),
+			catalog.WithLabelDescription("name", "Name of the VMI."),
+		),
 	)
 
 	cpuSystemUsageSeconds = operatormetrics.NewCounter(
-		operatormetrics.MetricOpts{
+		catalog.Opts(operatormetrics.MetricOpts{
 			Name: "kubevirt_vmi_cpu_system_usage_seconds_total",
 			Help: "Total CPU time spent in system mode.",
 		},
+			catalog.WithStabilityLevel(catalog.Stable),
+			catalog.WithLabelDescription("node", "Node the VMI is running on."),
+			catalog.WithLabelDescription("namespace", "Namespace of the VMI."),
+			catalog.WithLabelDescription("name", "Name of the VMI."),
+		),
+	)
+
+	cpuThrottledSeconds = operatormetrics.NewCounter(
+		catalog.Opts(operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_cpu_throttled_seconds_total",
+			Help: "Total time the virt-launcher cgroup was throttled because it exhausted its CPU limit.",
+		},
+			catalog.WithStabilityLevel(catalog.Alpha),
+			catalog.WithLabelDescription("node", "Node the VMI is running on."),
+			catalog.WithLabelDescription("namespace", "Namespace of the VMI."),
+			catalog.WithLabelDescription("name", "Name of the VMI."),
+		),
 	)
 
 	guestLoad1m = operatormetrics.NewGauge(
-		operatormetrics.MetricOpts{
+		catalog.Opts(operatormetrics.MetricOpts{
 			Name: "kubevirt_vmi_guest_load_1m",
 			Help: "Guest system load average over 1 minute as reported by the guest agent. " +
 				"Load is defined as the number of processes in the runqueue or waiting for disk I/O. " +
 				"Requires qemu-guest-agent version 10.0.0 or above.",
 		},
+			catalog.WithStabilityLevel(catalog.Stable),
+			catalog.WithLabelDescription("node", "Node the VMI is running on."),
+			catalog.WithLabelDescription("namespace", "Namespace of the VMI."),
+			catalog.WithLabelDescription("name", "Name of the VMI."),
+		),
 	)
 
 	guestLoad5m = operatormetrics.NewGauge(
-		operatormetrics.MetricOpts{
+		catalog.Opts(operatormetrics.MetricOpts{
 			Name: "kubevirt_vmi_guest_load_5m",
 			Help: "Guest system load average over 5 minutes as reported by the guest agent. " +
 				"Load is defined as the number of processes in the runqueue or waiting for disk I/O. " +
 				"Requires qemu-guest-agent version 10.0.0 or above.",
 		},
+			catalog.WithStabilityLevel(catalog.Stable),
+			catalog.WithLabelDescription("node", "Node the VMI is running on."),
+			catalog.WithLabelDescription("namespace", "Namespace of the VMI."),
+			catalog.WithLabelDescription("name", "Name of the VMI."),
+		),
 	)
 
 	guestLoad15m = operatormetrics.NewGauge(
-		operatormetrics.MetricOpts{
+		catalog.Opts(operatormetrics.MetricOpts{
 			Name: "kubevirt_vmi_guest_load_15m",
 			Help: "Guest system load average over 15 minutes as reported by the guest agent. " +
 				"Load is defined as the number of processes in the runqueue or waiting for disk I/O. " +
 				"Requires qemu-guest-agent version 10.0.0 or above.",
 		},
+			catalog.WithStabilityLevel(catalog.Stable),
+			catalog.WithLabelDescription("node", "Node the VMI is running on."),
+			catalog.WithLabelDescription("namespace", "Namespace of the VMI."),
+			catalog.WithLabelDescription("name", "Name of the VMI."),
+		),
 	)
 )
 
@@ -81,6 +125,7 @@ func (cpuMetrics) Describe() []operatormetrics.Metric {
 		cpuUsageSeconds,
 		cpuUserUsageSeconds,
 		cpuSystemUsageSeconds,
+		cpuThrottledSeconds,
 		guestLoad1m,
 		guestLoad5m,
 		guestLoad15m,
@@ -90,6 +135,10 @@ func (cpuMetrics) Describe() []operatormetrics.Metric {
 func (cpuMetrics) Collect(vmiReport *VirtualMachineInstanceReport) []operatormetrics.CollectorResult {
 	var crs []operatormetrics.CollectorResult
 
+	if vmiReport.vmiStats.CPUThrottledTime != nil {
+		crs = append(crs, vmiReport.newCollectorResult(cpuThrottledSeconds, vmiReport.vmiStats.CPUThrottledTime.Seconds()))
+	}
+
 	if vmiReport.vmiStats.DomainStats == nil {
 		return crs
 	}
@@ -20,6 +20,8 @@
 package domainstats
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
@@ -72,6 +74,25 @@ var _ = Describe("CPU Metrics", func() {
 			Expect(crs).To(BeEmpty())
 		})
 
+		Context("CPU throttling", func() {
+			It("should collect the throttled time in seconds", func() {
+				throttledTime := 1500 * time.Millisecond
+				vmiReport := newVirtualMachineInstanceReport(vmi, &VirtualMachineInstanceStats{
+					CPUThrottledTime: &throttledTime,
+				})
+
+				crs := cpuMetrics{}.Collect(vmiReport)
+				Expect(crs).To(HaveLen(1))
+				Expect(crs).To(ContainElement(testing.GomegaContainsCollectorResultMatcher(cpuThrottledSeconds, 1.5)))
+			})
+
+			It("should not collect the throttled time if the cgroup stats were not read", func() {
+				vmiReport := newVirtualMachineInstanceReport(vmi, &VirtualMachineInstanceStats{})
+
+				Expect(cpuMetrics{}.Collect(vmiReport)).To(BeEmpty())
+			})
+		})
+
 		Context("CPU load", func() {
 			BeforeEach(func() {
 				vmi = &k6tv1.VirtualMachineInstance{
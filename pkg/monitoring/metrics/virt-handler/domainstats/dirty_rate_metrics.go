@@ -22,13 +22,20 @@ package domainstats
 import (
 	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
 	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/monitoring/metrics/common/catalog"
 )
 
 var dirtyRateBytesPerSecond = operatormetrics.NewGauge(
-	operatormetrics.MetricOpts{
+	catalog.Opts(operatormetrics.MetricOpts{
 		Name: "kubevirt_vmi_dirty_rate_bytes_per_second",
 		Help: "Guest dirty-rate in bytes per second.",
 	},
+		catalog.WithStabilityLevel(catalog.Alpha),
+		catalog.WithLabelDescription("node", "Node the VMI is running on."),
+		catalog.WithLabelDescription("namespace", "Namespace of the VMI."),
+		catalog.WithLabelDescription("name", "Name of the VMI."),
+	),
 )
 
 type dirtyRateMetrics struct{}
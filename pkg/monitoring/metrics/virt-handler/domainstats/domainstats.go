@@ -21,6 +21,7 @@ package domainstats
 
 import (
 	"strings"
+	"time"
 
 	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
 	k6tv1 "kubevirt.io/api/core/v1"
@@ -45,6 +46,10 @@ type VirtualMachineInstanceReport struct {
 type VirtualMachineInstanceStats struct {
 	DomainStats *stats.DomainStats
 	FsStats     k6tv1.VirtualMachineInstanceFileSystemList
+	// UserList is nil when the guest users could not be read
+	UserList *k6tv1.VirtualMachineInstanceGuestOSUserList
+	// CPUThrottledTime is nil when the launcher cgroup stats could not be read
+	CPUThrottledTime *time.Duration
 }
 
 func newVirtualMachineInstanceReport(
@@ -19,21 +19,46 @@
 
 package domainstats
 
-import "github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
+import (
+	"strings"
+
+	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
+	k6tv1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/monitoring/metrics/common/catalog"
+)
 
 var (
 	filesystemCapacityBytes = operatormetrics.NewGauge(
-		operatormetrics.MetricOpts{
+		catalog.Opts(operatormetrics.MetricOpts{
 			Name: "kubevirt_vmi_filesystem_capacity_bytes",
 			Help: "Total VM filesystem capacity in bytes.",
 		},
+			catalog.WithStabilityLevel(catalog.Stable),
+			catalog.WithLabelDescription("node", "Node the VMI is running on."),
+			catalog.WithLabelDescription("namespace", "Namespace of the VMI."),
+			catalog.WithLabelDescription("name", "Name of the VMI."),
+			catalog.WithLabelDescription("disk_name", "Name of the guest disk backing the filesystem."),
+			catalog.WithLabelDescription("mount_point", "Mount point of the filesystem in the guest."),
+			catalog.WithLabelDescription("file_system_type", "Type of the filesystem, e.g. ext4."),
+			catalog.WithLabelDescription("disk_serial", "Comma separated serials of the disks backing the filesystem."),
+		),
 	)
 
 	filesystemUsedBytes = operatormetrics.NewGauge(
-		operatormetrics.MetricOpts{
+		catalog.Opts(operatormetrics.MetricOpts{
 			Name: "kubevirt_vmi_filesystem_used_bytes",
 			Help: "Used VM filesystem capacity in bytes.",
 		},
+			catalog.WithStabilityLevel(catalog.Stable),
+			catalog.WithLabelDescription("node", "Node the VMI is running on."),
+			catalog.WithLabelDescription("namespace", "Namespace of the VMI."),
+			catalog.WithLabelDescription("name", "Name of the VMI."),
+			catalog.WithLabelDescription("disk_name", "Name of the guest disk backing the filesystem."),
+			catalog.WithLabelDescription("mount_point", "Mount point of the filesystem in the guest."),
+			catalog.WithLabelDescription("file_system_type", "Type of the filesystem, e.g. ext4."),
+			catalog.WithLabelDescription("disk_serial", "Comma separated serials of the disks backing the filesystem."),
+		),
 	)
 )
 
@@ -54,6 +79,7 @@ func (filesystemMetrics) Collect(vmiReport *VirtualMachineInstanceReport) []oper
 			"disk_name":        fsStat.DiskName,
 			"mount_point":      fsStat.MountPoint,
 			"file_system_type": fsStat.FileSystemType,
+			"disk_serial":      getFilesystemDiskSerial(fsStat.Disk),
 		}
 
 		crs = append(crs,
@@ -64,3 +90,15 @@ func (filesystemMetrics) Collect(vmiReport *VirtualMachineInstanceReport) []oper
 
 	return crs
 }
+
+// getFilesystemDiskSerial joins the serials of the disks backing a guest
+// filesystem, as a filesystem may span several disks (e.g. LVM)
+func getFilesystemDiskSerial(disks []k6tv1.VirtualMachineInstanceFileSystemDisk) string {
+	var serials []string
+	for _, disk := range disks {
+		if disk.Serial != "" {
+			serials = append(serials, disk.Serial)
+		}
+	}
+	return strings.Join(serials, ",")
+}
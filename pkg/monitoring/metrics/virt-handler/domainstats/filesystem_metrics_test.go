@@ -48,6 +48,11 @@ var _ = Describe("filesystem metrics", func() {
 						FileSystemType: "ext4",
 						TotalBytes:     1,
 						UsedBytes:      2,
+						Disk: []k6tv1.VirtualMachineInstanceFileSystemDisk{
+							{Serial: "serial-1", BusType: "virtio"},
+							{BusType: "sata"},
+							{Serial: "serial-2", BusType: "virtio"},
+						},
 					},
 				},
 			},
@@ -63,6 +68,15 @@ var _ = Describe("filesystem metrics", func() {
 			Entry("kubevirt_vmi_filesystem_used_bytes", filesystemUsedBytes, 2.0),
 		)
 
+		It("should label the metrics with the serials of the backing disks", func() {
+			crs := filesystemMetrics{}.Collect(vmiReport)
+			Expect(crs).To(HaveLen(2))
+			for _, cr := range crs {
+				Expect(cr.ConstLabels).To(HaveKeyWithValue("mount_point", "/"))
+				Expect(cr.ConstLabels).To(HaveKeyWithValue("disk_serial", "serial-1,serial-2"))
+			}
+		})
+
 		It("result should be empty if stat not populated or set is false", func() {
 			vmiStats.FsStats.Items = []k6tv1.VirtualMachineInstanceFileSystem{}
 			crs := filesystemMetrics{}.Collect(vmiReport)
@@ -19,98 +19,157 @@
 
 package domainstats
 
-import "github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
+import (
+	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
+
+	"kubevirt.io/kubevirt/pkg/monitoring/metrics/common/catalog"
+)
 
 var (
 	memoryResident = operatormetrics.NewGauge(
-		operatormetrics.MetricOpts{
+		catalog.Opts(operatormetrics.MetricOpts{
 			Name: "kubevirt_vmi_memory_resident_bytes",
 			Help: "Resident set size of the process running the domain.",
 		},
+			catalog.WithStabilityLevel(catalog.Stable),
+			catalog.WithLabelDescription("node", "Node the VMI is running on."),
+			catalog.WithLabelDescription("namespace", "Namespace of the VMI."),
+			catalog.WithLabelDescription("name", "Name of the VMI."),
+		),
 	)
 
 	memoryAvailable = operatormetrics.NewGauge(
-		operatormetrics.MetricOpts{
+		catalog.Opts(operatormetrics.MetricOpts{
 			Name: "kubevirt_vmi_memory_available_bytes",
 			Help: "Amount of usable memory as seen by the domain. " +
 				"This value may not be accurate if a balloon driver is in use " +
 				"or if the guest OS does not initialize all assigned pages",
 		},
+			catalog.WithStabilityLevel(catalog.Stable),
+			catalog.WithLabelDescription("node", "Node the VMI is running on."),
+			catalog.WithLabelDescription("namespace", "Namespace of the VMI."),
+			catalog.WithLabelDescription("name", "Name of the VMI."),
+		),
 	)
 
 	memoryUnused = operatormetrics.NewGauge(
-		operatormetrics.MetricOpts{
+		catalog.Opts(operatormetrics.MetricOpts{
 			Name: "kubevirt_vmi_memory_unused_bytes",
 			Help: "The amount of memory left completely unused by the system. " +
 				"Memory that is available but used for reclaimable caches " +
 				"should NOT be reported as free.",
 		},
+			catalog.WithStabilityLevel(catalog.Stable),
+			catalog.WithLabelDescription("node", "Node the VMI is running on."),
+			catalog.WithLabelDescription("namespace", "Namespace of the VMI."),
+			catalog.WithLabelDescription("name", "Name of the VMI."),
+		),
 	)
 
 	memoryCached = operatormetrics.NewGauge(
-		operatormetrics.MetricOpts{
+		catalog.Opts(operatormetrics.MetricOpts{
 			Name: "kubevirt_vmi_memory_cached_bytes",
 			Help: "The amount of memory that is being used to cache I/O " +
 				"and is available to be reclaimed, corresponds to the sum of " +
 				"`Buffers` + `Cached` + `SwapCached` in `/proc/meminfo`.",
 		},
+			catalog.WithStabilityLevel(catalog.Stable),
+			catalog.WithLabelDescription("node", "Node the VMI is running on."),
+			catalog.WithLabelDescription("namespace", "Namespace of the VMI."),
+			catalog.WithLabelDescription("name", "Name of the VMI."),
+		),
 	)
 
 	memorySwapInTrafficBytes = operatormetrics.NewGauge(
-		operatormetrics.MetricOpts{
+		catalog.Opts(operatormetrics.MetricOpts{
 			Name: "kubevirt_vmi_memory_swap_in_traffic_bytes",
 			Help: "The total amount of data read from swap space of the guest in bytes.",
 		},
+			catalog.WithStabilityLevel(catalog.Stable),
+			catalog.WithLabelDescription("node", "Node the VMI is running on."),
+			catalog.WithLabelDescription("namespace", "Namespace of the VMI."),
+			catalog.WithLabelDescription("name", "Name of the VMI."),
+		),
 	)
 
 	memorySwapOutTrafficBytes = operatormetrics.NewGauge(
-		operatormetrics.MetricOpts{
+		catalog.Opts(operatormetrics.MetricOpts{
 			Name: "kubevirt_vmi_memory_swap_out_traffic_bytes",
 			Help: "The total amount of memory written out to swap space of the guest in bytes.",
 		},
+			catalog.WithStabilityLevel(catalog.Stable),
+			catalog.WithLabelDescription("node", "Node the VMI is running on."),
+			catalog.WithLabelDescription("namespace", "Namespace of the VMI."),
+			catalog.WithLabelDescription("name", "Name of the VMI."),
+		),
 	)
 
 	memoryPgmajfaultTotal = operatormetrics.NewCounter(
-		operatormetrics.MetricOpts{
+		catalog.Opts(operatormetrics.MetricOpts{
 			Name: "kubevirt_vmi_memory_pgmajfault_total",
 			Help: "The number of page faults when disk IO was required. " +
 				"Page faults occur when a process makes a valid access to virtual memory " +
 				"that is not available. When servicing the page fault, if disk IO is required, " +
 				"it is considered as major fault.",
 		},
+			catalog.WithStabilityLevel(catalog.Stable),
+			catalog.WithLabelDescription("node", "Node the VMI is running on."),
+			catalog.WithLabelDescription("namespace", "Namespace of the VMI."),
+			catalog.WithLabelDescription("name", "Name of the VMI."),
+		),
 	)
 
 	memoryPgminfaultTotal = operatormetrics.NewCounter(
-		operatormetrics.MetricOpts{
+		catalog.Opts(operatormetrics.MetricOpts{
 			Name: "kubevirt_vmi_memory_pgminfault_total",
 			Help: "The number of other page faults, when disk IO was not required. " +
 				"Page faults occur when a process makes a valid access to virtual memory " +
 				"that is not available. When servicing the page fault, if disk IO is NOT required, " +
 				"it is considered as minor fault.",
 		},
+			catalog.WithStabilityLevel(catalog.Stable),
+			catalog.WithLabelDescription("node", "Node the VMI is running on."),
+			catalog.WithLabelDescription("namespace", "Namespace of the VMI."),
+			catalog.WithLabelDescription("name", "Name of the VMI."),
+		),
 	)
 
 	memoryActualBallon = operatormetrics.NewGauge(
-		operatormetrics.MetricOpts{
+		catalog.Opts(operatormetrics.MetricOpts{
 			Name: "kubevirt_vmi_memory_actual_balloon_bytes",
 			Help: "Current balloon size in bytes.",
 		},
+			catalog.WithStabilityLevel(catalog.Stable),
+			catalog.WithLabelDescription("node", "Node the VMI is running on."),
+			catalog.WithLabelDescription("namespace", "Namespace of the VMI."),
+			catalog.WithLabelDescription("name", "Name of the VMI."),
+		),
 	)
 
 	memoryUsableBytes = operatormetrics.NewGauge(
-		operatormetrics.MetricOpts{
+		catalog.Opts(operatormetrics.MetricOpts{
 			Name: "kubevirt_vmi_memory_usable_bytes",
 			Help: "The amount of memory which can be reclaimed by balloon " +
 				"without pushing the guest system to swap, " +
 				"corresponds to 'Available' in /proc/meminfo.",
 		},
+			catalog.WithStabilityLevel(catalog.Stable),
+			catalog.WithLabelDescription("node", "Node the VMI is running on."),
+			catalog.WithLabelDescription("namespace", "Namespace of the VMI."),
+			catalog.WithLabelDescription("name", "Name of the VMI."),
+		),
 	)
 
 	memoryDomainBytes = operatormetrics.NewGauge(
-		operatormetrics.MetricOpts{
+		catalog.Opts(operatormetrics.MetricOpts{
 			Name: "kubevirt_vmi_memory_domain_bytes",
 			Help: "The amount of memory in bytes allocated to the domain. The `memory` value in domain xml file.",
 		},
+			catalog.WithStabilityLevel(catalog.Stable),
+			catalog.WithLabelDescription("node", "Node the VMI is running on."),
+			catalog.WithLabelDescription("namespace", "Namespace of the VMI."),
+			catalog.WithLabelDescription("name", "Name of the VMI."),
+		),
 	)
 )
 
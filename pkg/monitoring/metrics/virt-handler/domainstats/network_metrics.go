@@ -19,70 +19,129 @@
 
 package domainstats
 
-import "github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
+import (
+	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
+
+	"kubevirt.io/kubevirt/pkg/monitoring/metrics/common/catalog"
+)
 
 var (
 	networkTrafficBytesDeprecated = operatormetrics.NewCounter(
-		operatormetrics.MetricOpts{
+		catalog.Opts(operatormetrics.MetricOpts{
 			Name: "kubevirt_vmi_network_traffic_bytes_total",
-			Help: "[Deprecated] Total number of bytes sent and received.",
+			Help: "Total number of bytes sent and received.",
 		},
+			catalog.WithStabilityLevel(catalog.Deprecated),
+			catalog.WithLabelDescription("node", "Node the VMI is running on."),
+			catalog.WithLabelDescription("namespace", "Namespace of the VMI."),
+			catalog.WithLabelDescription("name", "Name of the VMI."),
+			catalog.WithLabelDescription("interface", "Name of the VMI network interface."),
+			catalog.WithLabelDescription("type", "Direction of the traffic, 'rx' or 'tx'."),
+		),
 	)
 
 	networkReceiveBytes = operatormetrics.NewCounter(
-		operatormetrics.MetricOpts{
+		catalog.Opts(operatormetrics.MetricOpts{
 			Name: "kubevirt_vmi_network_receive_bytes_total",
 			Help: "Total network traffic received in bytes.",
 		},
+			catalog.WithStabilityLevel(catalog.Stable),
+			catalog.WithLabelDescription("node", "Node the VMI is running on."),
+			catalog.WithLabelDescription("namespace", "Namespace of the VMI."),
+			catalog.WithLabelDescription("name", "Name of the VMI."),
+			catalog.WithLabelDescription("interface", "Name of the VMI network interface."),
+		),
 	)
 
 	networkTransmitBytes = operatormetrics.NewCounter(
-		operatormetrics.MetricOpts{
+		catalog.Opts(operatormetrics.MetricOpts{
 			Name: "kubevirt_vmi_network_transmit_bytes_total",
 			Help: "Total network traffic transmitted in bytes.",
 		},
+			catalog.WithStabilityLevel(catalog.Stable),
+			catalog.WithLabelDescription("node", "Node the VMI is running on."),
+			catalog.WithLabelDescription("namespace", "Namespace of the VMI."),
+			catalog.WithLabelDescription("name", "Name of the VMI."),
+			catalog.WithLabelDescription("interface", "Name of the VMI network interface."),
+		),
 	)
 
 	networkReceivePackets = operatormetrics.NewCounter(
-		operatormetrics.MetricOpts{
+		catalog.Opts(operatormetrics.MetricOpts{
 			Name: "kubevirt_vmi_network_receive_packets_total",
 			Help: "Total network traffic received packets.",
 		},
+			catalog.WithStabilityLevel(catalog.Stable),
+			catalog.WithLabelDescription("node", "Node the VMI is running on."),
+			catalog.WithLabelDescription("namespace", "Namespace of the VMI."),
+			catalog.WithLabelDescription("name", "Name of the VMI."),
+			catalog.WithLabelDescription("interface", "Name of the VMI network interface."),
+		),
 	)
 
 	networkTransmitPackets = operatormetrics.NewCounter(
-		operatormetrics.MetricOpts{
+		catalog.Opts(operatormetrics.MetricOpts{
 			Name: "kubevirt_vmi_network_transmit_packets_total",
 			Help: "Total network traffic transmitted packets.",
 		},
+			catalog.WithStabilityLevel(catalog.Stable),
+			catalog.WithLabelDescription("node", "Node the VMI is running on."),
+			catalog.WithLabelDescription("namespace", "Namespace of the VMI."),
+			catalog.WithLabelDescription("name", "Name of the VMI."),
+			catalog.WithLabelDescription("interface", "Name of the VMI network interface."),
+		),
 	)
 
 	networkReceiveErrors = operatormetrics.NewCounter(
-		operatormetrics.MetricOpts{
+		catalog.Opts(operatormetrics.MetricOpts{
 			Name: "kubevirt_vmi_network_receive_errors_total",
 			Help: "Total network received error packets.",
 		},
+			catalog.WithStabilityLevel(catalog.Stable),
+			catalog.WithLabelDescription("node", "Node the VMI is running on."),
+			catalog.WithLabelDescription("namespace", "Namespace of the VMI."),
+			catalog.WithLabelDescription("name", "Name of the VMI."),
+			catalog.WithLabelDescription("interface", "Name of the VMI network interface."),
+		),
 	)
 
 	networkTransmitErrors = operatormetrics.NewCounter(
-		operatormetrics.MetricOpts{
+		catalog.Opts(operatormetrics.MetricOpts{
 			Name: "kubevirt_vmi_network_transmit_errors_total",
 			Help: "Total network transmitted error packets.",
 		},
+			catalog.WithStabilityLevel(catalog.Stable),
+			catalog.WithLabelDescription("node", "Node the VMI is running on."),
+			catalog.WithLabelDescription("namespace", "Namespace of the VMI."),
+			catalog.WithLabelDescription("name", "Name of the VMI."),
+			catalog.WithLabelDescription("interface", "Name of the VMI network interface."),
+		),
 	)
 
 	networkReceivePacketsDropped = operatormetrics.NewCounter(
-		operatormetrics.MetricOpts{
+		catalog.Opts(operatormetrics.MetricOpts{
 			Name: "kubevirt_vmi_network_receive_packets_dropped_total",
 			Help: "The total number of rx packets dropped on vNIC interfaces.",
 		},
+			catalog.WithStabilityLevel(catalog.Stable),
+			catalog.WithLabelDescription("node", "Node the VMI is running on."),
+			catalog.WithLabelDescription("namespace", "Namespace of the VMI."),
+			catalog.WithLabelDescription("name", "Name of the VMI."),
+			catalog.WithLabelDescription("interface", "Name of the VMI network interface."),
+		),
 	)
 
 	networkTransmitPacketsDropped = operatormetrics.NewCounter(
-		operatormetrics.MetricOpts{
+		catalog.Opts(operatormetrics.MetricOpts{
 			Name: "kubevirt_vmi_network_transmit_packets_dropped_total",
 			Help: "The total number of tx packets dropped on vNIC interfaces.",
 		},
+			catalog.WithStabilityLevel(catalog.Stable),
+			catalog.WithLabelDescription("node", "Node the VMI is running on."),
+			catalog.WithLabelDescription("namespace", "Namespace of the VMI."),
+			catalog.WithLabelDescription("name", "Name of the VMI."),
+			catalog.WithLabelDescription("interface", "Name of the VMI network interface."),
+		),
 	)
 )
 
@@ -19,13 +19,22 @@
 
 package domainstats
 
-import "github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
+import (
+	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
+
+	"kubevirt.io/kubevirt/pkg/monitoring/metrics/common/catalog"
+)
 
 var nodeCPUAffinity = operatormetrics.NewGauge(
-	operatormetrics.MetricOpts{
+	catalog.Opts(operatormetrics.MetricOpts{
 		Name: "kubevirt_vmi_node_cpu_affinity",
 		Help: "Number of VMI CPU affinities to node physical cores.",
 	},
+		catalog.WithStabilityLevel(catalog.Stable),
+		catalog.WithLabelDescription("node", "Node the VMI is running on."),
+		catalog.WithLabelDescription("namespace", "Namespace of the VMI."),
+		catalog.WithLabelDescription("name", "Name of the VMI."),
+	),
 )
 
 type cpuAffinityMetrics struct{}
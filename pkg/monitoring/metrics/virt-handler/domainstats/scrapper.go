@@ -27,6 +27,7 @@ import (
 	"kubevirt.io/client-go/log"
 
 	"kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-handler/collector"
+	"kubevirt.io/kubevirt/pkg/virt-handler/cgroup"
 	cmdclient "kubevirt.io/kubevirt/pkg/virt-handler/cmd-client"
 )
 
@@ -66,6 +67,8 @@ func (d DomainstatsScraper) Scrape(socketFile string, vmi *k6tv1.VirtualMachineI
 		return
 	}
 
+	vmStats.CPUThrottledTime = gatherCPUThrottledTime(vmi)
+
 	report(vmi, vmStats, d.ch)
 }
 
@@ -105,5 +108,29 @@ func (d DomainstatsScraper) gatherMetrics(socketFile string) (bool, *VirtualMach
 		return false, nil, fmt.Errorf("failed to update filesystem stats from socket %s: %w", socketFile, err)
 	}
 
+	vmStats.UserList = gatherUsers(cli, socketFile)
+
 	return exists, vmStats, nil
 }
+
+func gatherUsers(cli cmdclient.LauncherClient, socketFile string) *k6tv1.VirtualMachineInstanceGuestOSUserList {
+	userList, err := cli.GetUsers()
+	if err != nil {
+		// The guest users are best effort, the remaining metrics are still reported
+		log.Log.V(logVerbosityWarning).Reason(err).Infof("failed to read guest users from socket %s", socketFile)
+		return nil
+	}
+
+	return &userList
+}
+
+func gatherCPUThrottledTime(vmi *k6tv1.VirtualMachineInstance) *time.Duration {
+	throttledTime, err := cgroup.GetCPUThrottledTime(vmi)
+	if err != nil {
+		// Throttling stats are best effort, the remaining metrics are still reported
+		log.Log.V(logVerbosityWarning).Reason(err).Infof("failed to read CPU throttling stats of %s", vmi.Name)
+		return nil
+	}
+
+	return &throttledTime
+}
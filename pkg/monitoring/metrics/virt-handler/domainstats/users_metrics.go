@@ -0,0 +1,71 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+package domainstats
+
+import (
+	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
+	k8sv1 "k8s.io/api/core/v1"
+	k6tv1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/monitoring/metrics/common/catalog"
+)
+
+var (
+	activeUsers = operatormetrics.NewGauge(
+		catalog.Opts(operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_active_users",
+			Help: "Number of users logged in to the guest, as reported by the guest agent.",
+		},
+			catalog.WithStabilityLevel(catalog.Alpha),
+			catalog.WithLabelDescription("node", "Node the VMI is running on."),
+			catalog.WithLabelDescription("namespace", "Namespace of the VMI."),
+			catalog.WithLabelDescription("name", "Name of the VMI."),
+		),
+	)
+)
+
+type usersMetrics struct{}
+
+func (usersMetrics) Describe() []operatormetrics.Metric {
+	return []operatormetrics.Metric{
+		activeUsers,
+	}
+}
+
+func (usersMetrics) Collect(vmiReport *VirtualMachineInstanceReport) []operatormetrics.CollectorResult {
+	// Without a connected guest agent the user list is always empty,
+	// which would be indistinguishable from a guest with no logged-in users
+	if !isAgentConnected(vmiReport.vmi) || vmiReport.vmiStats.UserList == nil {
+		return nil
+	}
+
+	return []operatormetrics.CollectorResult{
+		vmiReport.newCollectorResult(activeUsers, float64(len(vmiReport.vmiStats.UserList.Items))),
+	}
+}
+
+func isAgentConnected(vmi *k6tv1.VirtualMachineInstance) bool {
+	for _, cond := range vmi.Status.Conditions {
+		if cond.Type == k6tv1.VirtualMachineInstanceAgentConnected {
+			return cond.Status == k8sv1.ConditionTrue
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,90 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+package domainstats
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k6tv1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/monitoring/metrics/testing"
+)
+
+var _ = Describe("users metrics", func() {
+	Context("on Collect", func() {
+		newVMI := func(agentStatus k8sv1.ConditionStatus) *k6tv1.VirtualMachineInstance {
+			return &k6tv1.VirtualMachineInstance{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-vmi-1",
+					Namespace: "test-ns-1",
+				},
+				Status: k6tv1.VirtualMachineInstanceStatus{
+					Conditions: []k6tv1.VirtualMachineInstanceCondition{
+						{
+							Type:   k6tv1.VirtualMachineInstanceAgentConnected,
+							Status: agentStatus,
+						},
+					},
+				},
+			}
+		}
+
+		vmiStats := &VirtualMachineInstanceStats{
+			UserList: &k6tv1.VirtualMachineInstanceGuestOSUserList{
+				Items: []k6tv1.VirtualMachineInstanceGuestOSUser{
+					{UserName: "user-1"},
+					{UserName: "user-2"},
+				},
+			},
+		}
+
+		It("should collect the number of logged-in users", func() {
+			vmiReport := newVirtualMachineInstanceReport(newVMI(k8sv1.ConditionTrue), vmiStats)
+
+			crs := usersMetrics{}.Collect(vmiReport)
+			Expect(crs).To(ConsistOf(testing.GomegaContainsCollectorResultMatcher(activeUsers, 2.0)))
+		})
+
+		It("should report zero when the guest agent is connected and no user is logged in", func() {
+			vmiReport := newVirtualMachineInstanceReport(newVMI(k8sv1.ConditionTrue), &VirtualMachineInstanceStats{
+				UserList: &k6tv1.VirtualMachineInstanceGuestOSUserList{},
+			})
+
+			crs := usersMetrics{}.Collect(vmiReport)
+			Expect(crs).To(ConsistOf(testing.GomegaContainsCollectorResultMatcher(activeUsers, 0.0)))
+		})
+
+		It("result should be empty if the guest users could not be read", func() {
+			vmiReport := newVirtualMachineInstanceReport(newVMI(k8sv1.ConditionTrue), &VirtualMachineInstanceStats{})
+
+			crs := usersMetrics{}.Collect(vmiReport)
+			Expect(crs).To(BeEmpty())
+		})
+
+		It("result should be empty if the guest agent is not connected", func() {
+			vmiReport := newVirtualMachineInstanceReport(newVMI(k8sv1.ConditionFalse), vmiStats)
+
+			crs := usersMetrics{}.Collect(vmiReport)
+			Expect(crs).To(BeEmpty())
+		})
+	})
+})
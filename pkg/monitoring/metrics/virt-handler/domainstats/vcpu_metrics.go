@@ -24,31 +24,51 @@ import (
 
 	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
 
+	"kubevirt.io/kubevirt/pkg/monitoring/metrics/common/catalog"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/stats"
 )
 
 var (
 	vcpuSeconds = operatormetrics.NewCounter(
-		operatormetrics.MetricOpts{
+		catalog.Opts(operatormetrics.MetricOpts{
 			Name: "kubevirt_vmi_vcpu_seconds_total",
 			Help: "Total amount of time spent in each state by each vcpu " +
 				"(cpu_time excluding hypervisor time). Where `id` is the vcpu identifier " +
 				"and `state` can be one of the following: [`OFFLINE`, `RUNNING`, `BLOCKED`].",
 		},
+			catalog.WithStabilityLevel(catalog.Stable),
+			catalog.WithLabelDescription("node", "Node the VMI is running on."),
+			catalog.WithLabelDescription("namespace", "Namespace of the VMI."),
+			catalog.WithLabelDescription("name", "Name of the VMI."),
+			catalog.WithLabelDescription("id", "Index of the vCPU."),
+			catalog.WithLabelDescription("state", "State of the vCPU: 'running', 'blocked', 'offline' or 'unknown'."),
+		),
 	)
 
 	vcpuWaitSeconds = operatormetrics.NewCounter(
-		operatormetrics.MetricOpts{
+		catalog.Opts(operatormetrics.MetricOpts{
 			Name: "kubevirt_vmi_vcpu_wait_seconds_total",
 			Help: "Amount of time spent by each vcpu while waiting on I/O.",
 		},
+			catalog.WithStabilityLevel(catalog.Stable),
+			catalog.WithLabelDescription("node", "Node the VMI is running on."),
+			catalog.WithLabelDescription("namespace", "Namespace of the VMI."),
+			catalog.WithLabelDescription("name", "Name of the VMI."),
+			catalog.WithLabelDescription("id", "Index of the vCPU."),
+		),
 	)
 
 	vcpuDelaySeconds = operatormetrics.NewCounter(
-		operatormetrics.MetricOpts{
+		catalog.Opts(operatormetrics.MetricOpts{
 			Name: "kubevirt_vmi_vcpu_delay_seconds_total",
 			Help: "Amount of time spent by each vcpu waiting in the queue instead of running.",
 		},
+			catalog.WithStabilityLevel(catalog.Stable),
+			catalog.WithLabelDescription("node", "Node the VMI is running on."),
+			catalog.WithLabelDescription("namespace", "Namespace of the VMI."),
+			catalog.WithLabelDescription("name", "Name of the VMI."),
+			catalog.WithLabelDescription("id", "Index of the vCPU."),
+		),
 	)
 )
 
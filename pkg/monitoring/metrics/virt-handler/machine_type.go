@@ -22,6 +22,8 @@ package virthandler
 import (
 	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
 	"libvirt.org/go/libvirtxml"
+
+	"kubevirt.io/kubevirt/pkg/monitoring/metrics/common/catalog"
 )
 
 var (
@@ -29,7 +31,7 @@ var (
 		deprecatedMachineTypeMetric,
 	}
 
-	deprecatedMachineTypeMetric = operatormetrics.NewGaugeVec(
+	deprecatedMachineTypeMetric = catalog.NewGaugeVec(
 		operatormetrics.MetricOpts{
 			Name: "kubevirt_node_deprecated_machine_types",
 			Help: "List of deprecated machine types based on the capabilities of individual nodes, as detected by virt-handler.",
@@ -32,7 +32,7 @@ import (
 
 func SetupMetrics(
 	nodeName string, maxRequestsInFlight int,
-	vmiInformer cache.SharedIndexInformer, machines []libvirtxml.CapsGuestMachine,
+	vmiInformer cache.SharedIndexInformer, nodeStore cache.Store, machines []libvirtxml.CapsGuestMachine,
 ) error {
 	if err := workqueue.SetupMetrics(); err != nil {
 		return err
@@ -53,6 +53,7 @@ func SetupMetrics(
 	ReportDeprecatedMachineTypes(machines, nodeName)
 
 	domainstats.SetupDomainStatsCollector(maxRequestsInFlight, vmiInformer)
+	setupNodeCapacityCollector(nodeName, nodeStore, vmiInformer)
 
 	if err := migrationdomainstats.SetupMigrationStatsCollector(vmiInformer); err != nil {
 		return err
@@ -62,6 +63,7 @@ func SetupMetrics(
 		domainstats.Collector,
 		domainstats.DomainDirtyRateStatsCollector,
 		migrationdomainstats.MigrationStatsCollector,
+		nodeCapacityCollector,
 	)
 }
 
@@ -22,6 +22,8 @@ package migrationdomainstats
 import (
 	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
 	"k8s.io/client-go/tools/cache"
+
+	"kubevirt.io/kubevirt/pkg/monitoring/metrics/common/catalog"
 )
 
 var (
@@ -39,38 +41,63 @@ var (
 	}
 
 	migrateVMIDataTotal = operatormetrics.NewCounter(
-		operatormetrics.MetricOpts{
+		catalog.Opts(operatormetrics.MetricOpts{
 			Name: "kubevirt_vmi_migration_data_bytes_total",
 			Help: "The total Guest OS data to be migrated to the new VM.",
 		},
+			catalog.WithStabilityLevel(catalog.Stable),
+			catalog.WithLabelDescription("namespace", "Namespace of the migrating VMI."),
+			catalog.WithLabelDescription("name", "Name of the migrating VMI."),
+			catalog.WithLabelDescription("node", "Node the migrating VMI is running on."),
+		),
 	)
 
 	migrateVMIDataRemaining = operatormetrics.NewGauge(
-		operatormetrics.MetricOpts{
+		catalog.Opts(operatormetrics.MetricOpts{
 			Name: "kubevirt_vmi_migration_data_remaining_bytes",
 			Help: "The remaining guest OS data to be migrated to the new VM.",
 		},
+			catalog.WithStabilityLevel(catalog.Stable),
+			catalog.WithLabelDescription("namespace", "Namespace of the migrating VMI."),
+			catalog.WithLabelDescription("name", "Name of the migrating VMI."),
+			catalog.WithLabelDescription("node", "Node the migrating VMI is running on."),
+		),
 	)
 
 	migrateVMIDataProcessed = operatormetrics.NewGauge(
-		operatormetrics.MetricOpts{
+		catalog.Opts(operatormetrics.MetricOpts{
 			Name: "kubevirt_vmi_migration_data_processed_bytes",
 			Help: "The total Guest OS data processed and migrated to the new VM.",
 		},
+			catalog.WithStabilityLevel(catalog.Stable),
+			catalog.WithLabelDescription("namespace", "Namespace of the migrating VMI."),
+			catalog.WithLabelDescription("name", "Name of the migrating VMI."),
+			catalog.WithLabelDescription("node", "Node the migrating VMI is running on."),
+		),
 	)
 
 	migrateVmiDirtyMemoryRate = operatormetrics.NewGauge(
-		operatormetrics.MetricOpts{
+		catalog.Opts(operatormetrics.MetricOpts{
 			Name: "kubevirt_vmi_migration_dirty_memory_rate_bytes",
 			Help: "The rate of memory being dirty in the Guest OS.",
 		},
+			catalog.WithStabilityLevel(catalog.Stable),
+			catalog.WithLabelDescription("namespace", "Namespace of the migrating VMI."),
+			catalog.WithLabelDescription("name", "Name of the migrating VMI."),
+			catalog.WithLabelDescription("node", "Node the migrating VMI is running on."),
+		),
 	)
 
 	migrateVmiMemoryTransferRate = operatormetrics.NewGauge(
-		operatormetrics.MetricOpts{
+		catalog.Opts(operatormetrics.MetricOpts{
 			Name: "kubevirt_vmi_migration_memory_transfer_rate_bytes",
 			Help: "The rate at which the memory is being transferred.",
 		},
+			catalog.WithStabilityLevel(catalog.Stable),
+			catalog.WithLabelDescription("namespace", "Namespace of the migrating VMI."),
+			catalog.WithLabelDescription("name", "Name of the migrating VMI."),
+			catalog.WithLabelDescription("node", "Node the migrating VMI is running on."),
+		),
 	)
 )
 
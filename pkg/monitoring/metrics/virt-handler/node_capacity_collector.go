@@ -0,0 +1,191 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virthandler
+
+import (
+	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/monitoring/metrics/common/catalog"
+	"kubevirt.io/kubevirt/pkg/network/vmispec"
+)
+
+const (
+	kvmDeviceResource k8sv1.ResourceName = "devices.kubevirt.io/kvm"
+
+	accelerationKVM      = "kvm"
+	accelerationEmulated = "emulated"
+)
+
+var (
+	nodeCapacity nodeCapacitySettings
+
+	nodeCapacityCollector = operatormetrics.Collector{
+		Metrics: []operatormetrics.Metric{
+			nodeKVMDevicesAllocatable,
+			nodeVMIHugepages,
+			nodeVMISRIOVVFs,
+			nodeVMIs,
+		},
+		CollectCallback: nodeCapacityCollectorCallback,
+	}
+
+	nodeKVMDevicesAllocatable = catalog.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_node_kvm_devices_allocatable",
+			Help: "The number of allocatable KVM devices on the node, as detected by virt-handler.",
+		},
+		[]string{"node"},
+	)
+
+	nodeVMIHugepages = catalog.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_node_vmi_hugepages_bytes",
+			Help: "The amount of hugepages memory committed to the VirtualMachineInstances on the node, by page size.",
+		},
+		[]string{"node", "page_size"},
+	)
+
+	nodeVMISRIOVVFs = catalog.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_node_vmi_sriov_vfs_in_use",
+			Help: "The number of SR-IOV virtual functions used by the VirtualMachineInstances on the node.",
+		},
+		[]string{"node"},
+	)
+
+	nodeVMIs = catalog.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_node_vmis",
+			Help: "The number of VirtualMachineInstances on the node, by whether they run " +
+				"with KVM hardware acceleration or with software emulation.",
+		},
+		[]string{"node", "acceleration"},
+	)
+)
+
+type nodeCapacitySettings struct {
+	nodeName    string
+	nodeStore   cache.Store
+	vmiInformer cache.SharedIndexInformer
+}
+
+func setupNodeCapacityCollector(nodeName string, nodeStore cache.Store, vmiInformer cache.SharedIndexInformer) {
+	nodeCapacity = nodeCapacitySettings{
+		nodeName:    nodeName,
+		nodeStore:   nodeStore,
+		vmiInformer: vmiInformer,
+	}
+}
+
+func nodeCapacityCollectorCallback() []operatormetrics.CollectorResult {
+	if nodeCapacity.nodeStore == nil || nodeCapacity.vmiInformer == nil {
+		return nil
+	}
+
+	obj, exists, err := nodeCapacity.nodeStore.GetByKey(nodeCapacity.nodeName)
+	if err != nil {
+		log.Log.Reason(err).Errorf("failed to get node %s", nodeCapacity.nodeName)
+		return nil
+	}
+	if !exists {
+		return nil
+	}
+
+	node, ok := obj.(*k8sv1.Node)
+	if !ok {
+		return nil
+	}
+
+	var vmis []*v1.VirtualMachineInstance
+	for _, obj := range nodeCapacity.vmiInformer.GetStore().List() {
+		vmi, ok := obj.(*v1.VirtualMachineInstance)
+		if ok && vmi.Status.NodeName == node.Name && !vmi.IsFinal() {
+			vmis = append(vmis, vmi)
+		}
+	}
+
+	return collectNodeCapacity(node, vmis)
+}
+
+func collectNodeCapacity(node *k8sv1.Node, vmis []*v1.VirtualMachineInstance) []operatormetrics.CollectorResult {
+	kvmDevices := node.Status.Allocatable[kvmDeviceResource]
+	acceleration := accelerationEmulated
+	if kvmDevices.Value() > 0 {
+		acceleration = accelerationKVM
+	}
+
+	hugepages := map[string]int64{}
+	sriovVFs := 0
+	for _, vmi := range vmis {
+		if pageSize, size, ok := getHugepages(vmi); ok {
+			hugepages[pageSize] += size
+		}
+		sriovVFs += len(vmispec.FilterSRIOVInterfaces(vmi.Spec.Domain.Devices.Interfaces))
+	}
+
+	crs := []operatormetrics.CollectorResult{
+		{
+			Metric: nodeKVMDevicesAllocatable,
+			Labels: []string{node.Name},
+			Value:  float64(kvmDevices.Value()),
+		},
+		{
+			Metric: nodeVMISRIOVVFs,
+			Labels: []string{node.Name},
+			Value:  float64(sriovVFs),
+		},
+		{
+			Metric: nodeVMIs,
+			Labels: []string{node.Name, acceleration},
+			Value:  float64(len(vmis)),
+		},
+	}
+
+	for pageSize, size := range hugepages {
+		crs = append(crs, operatormetrics.CollectorResult{
+			Metric: nodeVMIHugepages,
+			Labels: []string{node.Name, pageSize},
+			Value:  float64(size),
+		})
+	}
+
+	return crs
+}
+
+// getHugepages returns the hugepages size of the VMI the way the launcher pod requests it:
+// the memory request, capped to the guest memory when one is set.
+func getHugepages(vmi *v1.VirtualMachineInstance) (string, int64, bool) {
+	memory := vmi.Spec.Domain.Memory
+	if memory == nil || memory.Hugepages == nil {
+		return "", 0, false
+	}
+
+	size := vmi.Spec.Domain.Resources.Requests.Memory()
+	if memory.Guest != nil && size.Cmp(*memory.Guest) > 0 {
+		size = memory.Guest
+	}
+
+	return memory.Hugepages.PageSize, size.Value(), true
+}
@@ -0,0 +1,103 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virthandler
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+var _ = Describe("node capacity collector", func() {
+	newNode := func(kvmDevices string) *k8sv1.Node {
+		return &k8sv1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-node"},
+			Status: k8sv1.NodeStatus{
+				Allocatable: k8sv1.ResourceList{kvmDeviceResource: resource.MustParse(kvmDevices)},
+			},
+		}
+	}
+
+	newVMI := func(memory string, hugepages *v1.Hugepages, guest *resource.Quantity, ifaces ...v1.Interface) *v1.VirtualMachineInstance {
+		vmi := &v1.VirtualMachineInstance{}
+		vmi.Spec.Domain.Resources.Requests = k8sv1.ResourceList{k8sv1.ResourceMemory: resource.MustParse(memory)}
+		vmi.Spec.Domain.Memory = &v1.Memory{Hugepages: hugepages, Guest: guest}
+		vmi.Spec.Domain.Devices.Interfaces = ifaces
+		return vmi
+	}
+
+	resultsByMetric := func(crs []operatormetrics.CollectorResult) map[string][]operatormetrics.CollectorResult {
+		results := map[string][]operatormetrics.CollectorResult{}
+		for _, cr := range crs {
+			name := cr.Metric.GetOpts().Name
+			results[name] = append(results[name], cr)
+		}
+		return results
+	}
+
+	sriov := v1.Interface{Name: "sriov", InterfaceBindingMethod: v1.InterfaceBindingMethod{SRIOV: &v1.InterfaceSRIOV{}}}
+	masquerade := v1.Interface{Name: "default", InterfaceBindingMethod: v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}}}
+
+	It("should report the node capacity used by its VMIs", func() {
+		guest := resource.MustParse("1Gi")
+		vmis := []*v1.VirtualMachineInstance{
+			newVMI("2Gi", &v1.Hugepages{PageSize: "2Mi"}, nil, masquerade, sriov),
+			newVMI("2Gi", &v1.Hugepages{PageSize: "2Mi"}, &guest, sriov, sriov),
+			newVMI("4Gi", &v1.Hugepages{PageSize: "1Gi"}, nil),
+			newVMI("8Gi", nil, nil, masquerade),
+		}
+
+		results := resultsByMetric(collectNodeCapacity(newNode("110"), vmis))
+
+		Expect(results["kubevirt_node_kvm_devices_allocatable"]).To(ConsistOf(
+			HaveField("Value", 110.0),
+		))
+		Expect(results["kubevirt_node_vmi_sriov_vfs_in_use"]).To(ConsistOf(
+			HaveField("Value", 3.0),
+		))
+		Expect(results["kubevirt_node_vmis"]).To(ConsistOf(
+			And(HaveField("Labels", []string{"test-node", "kvm"}), HaveField("Value", 4.0)),
+		))
+		Expect(results["kubevirt_node_vmi_hugepages_bytes"]).To(ConsistOf(
+			And(HaveField("Labels", []string{"test-node", "2Mi"}), HaveField("Value", float64(3*1024*1024*1024))),
+			And(HaveField("Labels", []string{"test-node", "1Gi"}), HaveField("Value", float64(4*1024*1024*1024))),
+		))
+	})
+
+	It("should report VMIs as emulated on a node without KVM devices", func() {
+		results := resultsByMetric(collectNodeCapacity(newNode("0"), []*v1.VirtualMachineInstance{newVMI("1Gi", nil, nil)}))
+
+		Expect(results["kubevirt_node_vmis"]).To(ConsistOf(
+			And(HaveField("Labels", []string{"test-node", "emulated"}), HaveField("Value", 1.0)),
+		))
+		Expect(results["kubevirt_node_vmi_hugepages_bytes"]).To(BeEmpty())
+	})
+
+	It("should not report anything before the collector is set up", func() {
+		setupNodeCapacityCollector("", nil, nil)
+		Expect(nodeCapacityCollectorCallback()).To(BeEmpty())
+	})
+})
@@ -22,6 +22,8 @@ package virthandler
 import (
 	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
 	"kubevirt.io/client-go/version"
+
+	"kubevirt.io/kubevirt/pkg/monitoring/metrics/common/catalog"
 )
 
 var (
@@ -29,7 +31,7 @@ var (
 		versionInfo,
 	}
 
-	versionInfo = operatormetrics.NewGaugeVec(
+	versionInfo = catalog.NewGaugeVec(
 		operatormetrics.MetricOpts{
 			Name: "kubevirt_info",
 			Help: "Version information.",
@@ -39,6 +39,14 @@ var vmiRecordingRules = []operatorrules.RecordingRule{
 				"(kubevirt_vmi_info)",
 		),
 	},
+	{
+		MetricsOpts: operatormetrics.MetricOpts{
+			Name: "node:kubevirt_vmi_evictable:count",
+			Help: "The number of VMIs per node that can be evicted, either because they can be live migrated or because they are not protected by an eviction strategy.",
+		},
+		MetricType: operatormetrics.GaugeType,
+		Expr:       intstr.FromString("count by (node) (kubevirt_vmi_non_evictable == 0)"),
+	},
 	{
 		MetricsOpts: operatormetrics.MetricOpts{
 			Name: "vmi:kubevirt_vmi_memory_used_bytes:sum",
@@ -119,4 +127,26 @@ var vmiRecordingRules = []operatorrules.RecordingRule{
 				" sum by (name, namespace) (rate(kubevirt_vmi_memory_swap_out_traffic_bytes[30m]))",
 		),
 	},
+	{
+		MetricsOpts: operatormetrics.MetricOpts{
+			Name: "vmi:kubevirt_vmi_storage_read_latency_seconds:rate5m",
+			Help: "Average latency of the read requests over 5 minutes per VMI drive (aggregated by name, namespace, drive).",
+		},
+		MetricType: operatormetrics.GaugeType,
+		Expr: intstr.FromString(
+			"sum by (name, namespace, drive) (rate(kubevirt_vmi_storage_read_times_seconds_total[5m])) / " +
+				"(sum by (name, namespace, drive) (rate(kubevirt_vmi_storage_iops_read_total[5m])) > 0)",
+		),
+	},
+	{
+		MetricsOpts: operatormetrics.MetricOpts{
+			Name: "vmi:kubevirt_vmi_storage_write_latency_seconds:rate5m",
+			Help: "Average latency of the write requests over 5 minutes per VMI drive (aggregated by name, namespace, drive).",
+		},
+		MetricType: operatormetrics.GaugeType,
+		Expr: intstr.FromString(
+			"sum by (name, namespace, drive) (rate(kubevirt_vmi_storage_write_times_seconds_total[5m])) / " +
+				"(sum by (name, namespace, drive) (rate(kubevirt_vmi_storage_iops_write_total[5m])) > 0)",
+		),
+	},
 }
@@ -23,20 +23,24 @@ import (
 	"context"
 	"fmt"
 
+	k8scorev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	v1 "kubevirt.io/api/core/v1"
 
 	"kubevirt.io/client-go/kubevirt"
 
 	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	metrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-controller"
 	"kubevirt.io/kubevirt/pkg/network/namescheme"
 	"kubevirt.io/kubevirt/pkg/network/vmispec"
 )
 
 type VMController struct {
 	clientset kubevirt.Interface
+	recorder  record.EventRecorder
 }
 
 type syncError struct {
@@ -58,11 +62,21 @@ func (e *syncError) RequiresRequeue() bool {
 
 const (
 	hotPlugNetworkInterfaceErrorReason = "HotPlugNetworkInterfaceError"
+
+	// SuccessfulHotplugInterfaceReason is added in an event when an interface hotplug request is applied to the VMI
+	SuccessfulHotplugInterfaceReason = "SuccessfulHotplugInterface"
+	// FailedHotplugInterfaceReason is added in an event when an interface hotplug request fails to be applied to the VMI
+	FailedHotplugInterfaceReason = "FailedHotplugInterface"
+	// SuccessfulHotunplugInterfaceReason is added in an event when an interface hotunplug request is applied to the VMI
+	SuccessfulHotunplugInterfaceReason = "SuccessfulHotunplugInterface"
+	// FailedHotunplugInterfaceReason is added in an event when an interface hotunplug request fails to be applied to the VMI
+	FailedHotunplugInterfaceReason = "FailedHotunplugInterface"
 )
 
-func NewVMController(clientset kubevirt.Interface) *VMController {
+func NewVMController(clientset kubevirt.Interface, recorder record.EventRecorder) *VMController {
 	return &VMController{
 		clientset: clientset,
+		recorder:  recorder,
 	}
 }
 
@@ -82,8 +96,14 @@ func (v *VMController) Sync(vm *v1.VirtualMachine, vmi *v1.VirtualMachineInstanc
 		)
 
 		updatedVMI := syncVMIInterfaces(vm, vmi, vmiIfaceStatusesByName)
+		pluggedIfaces, unpluggedIfaces := interfaceHotplugRequests(
+			vmi.Spec.Domain.Devices.Interfaces,
+			updatedVMI.Spec.Domain.Devices.Interfaces,
+		)
 
-		if err := v.vmiInterfacesPatch(&updatedVMI.Spec, vmi); err != nil {
+		err := v.vmiInterfacesPatch(&updatedVMI.Spec, vmi)
+		v.recordInterfaceHotplugRequests(vm, pluggedIfaces, unpluggedIfaces, err)
+		if err != nil {
 			return vm, &syncError{
 				fmt.Errorf("error encountered when trying to patch vmi: %v", err),
 				hotPlugNetworkInterfaceErrorReason,
@@ -146,6 +166,48 @@ func (v *VMController) vmiInterfacesPatch(newVmiSpec *v1.VirtualMachineInstanceS
 	return err
 }
 
+// interfaceHotplugRequests returns the names of the interfaces the updated VMI interfaces
+// plug and unplug compared to the current ones.
+func interfaceHotplugRequests(currentIfaces, updatedIfaces []v1.Interface) (plugged, unplugged []string) {
+	currentIfacesByName := vmispec.IndexInterfaceSpecByName(currentIfaces)
+	for _, iface := range updatedIfaces {
+		currentIface, exists := currentIfacesByName[iface.Name]
+		switch {
+		case !exists:
+			plugged = append(plugged, iface.Name)
+		case currentIface.State != v1.InterfaceStateAbsent && iface.State == v1.InterfaceStateAbsent:
+			unplugged = append(unplugged, iface.Name)
+		}
+	}
+	return plugged, unplugged
+}
+
+func (v *VMController) recordInterfaceHotplugRequests(vm *v1.VirtualMachine, plugged, unplugged []string, err error) {
+	for _, name := range plugged {
+		if err != nil {
+			metrics.HotplugInterfaceFailed()
+			v.recorder.Eventf(vm, k8scorev1.EventTypeWarning, FailedHotplugInterfaceReason,
+				"Failed to hotplug interface for network %s: %v", name, err)
+			continue
+		}
+		metrics.HotplugInterfaceSucceeded()
+		v.recorder.Eventf(vm, k8scorev1.EventTypeNormal, SuccessfulHotplugInterfaceReason,
+			"Requested hotplug of interface for network %s", name)
+	}
+
+	for _, name := range unplugged {
+		if err != nil {
+			metrics.HotunplugInterfaceFailed()
+			v.recorder.Eventf(vm, k8scorev1.EventTypeWarning, FailedHotunplugInterfaceReason,
+				"Failed to hotunplug interface for network %s: %v", name, err)
+			continue
+		}
+		metrics.HotunplugInterfaceSucceeded()
+		v.recorder.Eventf(vm, k8scorev1.EventTypeNormal, SuccessfulHotunplugInterfaceReason,
+			"Requested hotunplug of interface for network %s", name)
+	}
+}
+
 func applyDynamicIfaceRequestOnVMI(
 	vm *v1.VirtualMachine,
 	vmi *v1.VirtualMachineInstance,
@@ -29,6 +29,7 @@ import (
 	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8sruntime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/record"
 
 	"kubevirt.io/client-go/kubevirt/fake"
 
@@ -39,6 +40,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/network/controllers"
 	"kubevirt.io/kubevirt/pkg/network/namescheme"
 	"kubevirt.io/kubevirt/pkg/network/vmispec"
+	"kubevirt.io/kubevirt/pkg/testutils"
 )
 
 var _ = Describe("VM Network Controller", func() {
@@ -54,7 +56,7 @@ var _ = Describe("VM Network Controller", func() {
 		updatedNADName2   = "new-nad2"
 	)
 	DescribeTable("sync does nothing when", func(vm *v1.VirtualMachine, vmi *v1.VirtualMachineInstance) {
-		c := controllers.NewVMController(fake.NewSimpleClientset())
+		c := controllers.NewVMController(fake.NewSimpleClientset(), record.NewFakeRecorder(10))
 		originalVM := vm.DeepCopy()
 		Expect(c.Sync(vm, vmi)).To(Equal(originalVM))
 	},
@@ -97,7 +99,8 @@ var _ = Describe("VM Network Controller", func() {
 
 	It("sync fails when VMI patch returns an error", func() {
 		clientset := fake.NewSimpleClientset()
-		c := controllers.NewVMController(clientset)
+		recorder := record.NewFakeRecorder(10)
+		c := controllers.NewVMController(clientset, recorder)
 
 		// Setup `Patch` to fail.
 		injectedPatchError := errors.New("test patch error")
@@ -125,11 +128,13 @@ var _ = Describe("VM Network Controller", func() {
 		Expect(err).To(MatchError(isSyncErrorType, "syncError"))
 		Expect(err).To(MatchError(ContainSubstring(injectedPatchError.Error())))
 		Expect(updatedVM).To(Equal(originalVM))
+		testutils.ExpectEvent(recorder, controllers.FailedHotplugInterfaceReason)
 	})
 
 	DescribeTable("sync succeeds to hotplug new interface", func(ifaceToPlug v1.Interface) {
 		clientset := fake.NewSimpleClientset()
-		c := controllers.NewVMController(clientset)
+		recorder := record.NewFakeRecorder(10)
+		c := controllers.NewVMController(clientset, recorder)
 		vmi := libvmi.New(
 			libvmi.WithInterface(libvmi.InterfaceDeviceWithMasqueradeBinding()),
 			libvmi.WithNetwork(v1.DefaultPodNetwork()),
@@ -156,6 +161,7 @@ var _ = Describe("VM Network Controller", func() {
 
 		Expect(updatedVMI.Spec.Networks).To(Equal(updatedVM.Spec.Template.Spec.Networks))
 		Expect(updatedVMI.Spec.Domain.Devices.Interfaces).To(Equal(updatedVM.Spec.Template.Spec.Domain.Devices.Interfaces))
+		testutils.ExpectEvent(recorder, controllers.SuccessfulHotplugInterfaceReason)
 	},
 		Entry("when the plugged interface uses bridge binding", libvmi.InterfaceDeviceWithBridgeBinding(secondaryNetName1)),
 		Entry("when the plugged interface uses SR-IOV binding", libvmi.InterfaceDeviceWithSRIOVBinding(secondaryNetName1)),
@@ -177,7 +183,7 @@ var _ = Describe("VM Network Controller", func() {
 
 	It("sync does not hotplug a new absent interface", func() {
 		clientset := fake.NewSimpleClientset()
-		c := controllers.NewVMController(clientset)
+		c := controllers.NewVMController(clientset, record.NewFakeRecorder(10))
 		vmi := libvmi.New(
 			libvmi.WithInterface(libvmi.InterfaceDeviceWithMasqueradeBinding()),
 			libvmi.WithNetwork(v1.DefaultPodNetwork()),
@@ -217,7 +223,7 @@ var _ = Describe("VM Network Controller", func() {
 
 	DescribeTable("sync succeeds to mark an existing interface for hotunplug", func(currentIfaceState v1.InterfaceState) {
 		clientset := fake.NewSimpleClientset()
-		c := controllers.NewVMController(clientset)
+		c := controllers.NewVMController(clientset, record.NewFakeRecorder(10))
 
 		multusAndDomainInfoSource := vmispec.NewInfoSource(vmispec.InfoSourceMultusStatus, vmispec.InfoSourceDomain)
 
@@ -274,7 +280,7 @@ var _ = Describe("VM Network Controller", func() {
 
 	It("sync does not hotplug a new interface when it uses binding other than bridge or SR-IOV", func() {
 		clientset := fake.NewSimpleClientset()
-		c := controllers.NewVMController(clientset)
+		c := controllers.NewVMController(clientset, record.NewFakeRecorder(10))
 
 		vmi := libvmi.New()
 		vm := libvmi.NewVirtualMachine(vmi.DeepCopy())
@@ -303,7 +309,7 @@ var _ = Describe("VM Network Controller", func() {
 
 	It("sync succeeds to clear hotunplug interfaces from running VM", func() {
 		clientset := fake.NewSimpleClientset()
-		c := controllers.NewVMController(clientset)
+		c := controllers.NewVMController(clientset, record.NewFakeRecorder(10))
 		unpluggedIface := libvmi.InterfaceDeviceWithBridgeBinding("foonet")
 		unpluggedIface.State = v1.InterfaceStateAbsent
 		vmi := libvmi.New(
@@ -339,7 +345,7 @@ var _ = Describe("VM Network Controller", func() {
 
 	It("sync succeeds to clear hotunplug interfaces from stopped VM", func() {
 		clientset := fake.NewSimpleClientset()
-		c := controllers.NewVMController(clientset)
+		c := controllers.NewVMController(clientset, record.NewFakeRecorder(10))
 		unpluggedIface := libvmi.InterfaceDeviceWithBridgeBinding("foonet")
 		unpluggedIface.State = v1.InterfaceStateAbsent
 		vmi := libvmi.New(
@@ -361,7 +367,7 @@ var _ = Describe("VM Network Controller", func() {
 
 	It("sync does not hotunplug interfaces when nameing scheme is unknown", func() {
 		clientset := fake.NewSimpleClientset()
-		c := controllers.NewVMController(clientset)
+		c := controllers.NewVMController(clientset, record.NewFakeRecorder(10))
 		vmi := libvmi.New(
 			libvmi.WithInterface(libvmi.InterfaceDeviceWithMasqueradeBinding()),
 			libvmi.WithNetwork(v1.DefaultPodNetwork()),
@@ -405,7 +411,7 @@ var _ = Describe("VM Network Controller", func() {
 
 	DescribeTable("sync updates link state of an existing interface", func(fromState, toState v1.InterfaceState) {
 		clientset := fake.NewSimpleClientset()
-		c := controllers.NewVMController(clientset)
+		c := controllers.NewVMController(clientset, record.NewFakeRecorder(10))
 		const defaultNetName = "default"
 		vmi := libvmi.New(
 			libvmi.WithInterface(v1.Interface{
@@ -459,7 +465,7 @@ var _ = Describe("VM Network Controller", func() {
 
 	DescribeTable("sync doesn't update link state if hot-unplug is underway ", func(toState v1.InterfaceState) {
 		clientset := fake.NewSimpleClientset()
-		c := controllers.NewVMController(clientset)
+		c := controllers.NewVMController(clientset, record.NewFakeRecorder(10))
 		const defaultNetName = "default"
 		vmi := libvmi.New(
 			libvmi.WithInterface(v1.Interface{
@@ -505,7 +511,7 @@ var _ = Describe("VM Network Controller", func() {
 
 	It("sync does not hotunplug interfaces when legacy ordinal interface names are found", func() {
 		clientset := fake.NewSimpleClientset()
-		c := controllers.NewVMController(clientset)
+		c := controllers.NewVMController(clientset, record.NewFakeRecorder(10))
 		vmi := libvmi.New(
 			libvmi.WithInterface(libvmi.InterfaceDeviceWithMasqueradeBinding()),
 			libvmi.WithNetwork(v1.DefaultPodNetwork()),
@@ -560,7 +566,8 @@ var _ = Describe("VM Network Controller", func() {
 		)
 
 		clientset := fake.NewSimpleClientset()
-		c := controllers.NewVMController(clientset)
+		recorder := record.NewFakeRecorder(10)
+		c := controllers.NewVMController(clientset, recorder)
 
 		multusAndDomainInfoSource := vmispec.NewInfoSource(vmispec.InfoSourceMultusStatus, vmispec.InfoSourceDomain)
 
@@ -613,6 +620,10 @@ var _ = Describe("VM Network Controller", func() {
 
 		Expect(updatedVMI.Spec.Networks).To(Equal(originalVM.Spec.Template.Spec.Networks))
 		Expect(updatedVMI.Spec.Domain.Devices.Interfaces).To(Equal(originalVM.Spec.Template.Spec.Domain.Devices.Interfaces))
+		testutils.ExpectEvents(recorder,
+			controllers.SuccessfulHotplugInterfaceReason,
+			controllers.SuccessfulHotunplugInterfaceReason,
+		)
 	})
 
 	It("sync does not hotunplug interfaces when a pending hotplug without a status entry exists", func() {
@@ -621,7 +632,7 @@ var _ = Describe("VM Network Controller", func() {
 			netToDetachNADName = "detach-me-nad"
 		)
 		clientset := fake.NewSimpleClientset()
-		c := controllers.NewVMController(clientset)
+		c := controllers.NewVMController(clientset, record.NewFakeRecorder(10))
 
 		vmi := libvmi.New(
 			libvmi.WithInterface(libvmi.InterfaceDeviceWithMasqueradeBinding()),
@@ -667,7 +678,7 @@ var _ = Describe("VM Network Controller", func() {
 
 	It("sync handles NAD reference updates by copying NAD reference to VMI", func() {
 		clientset := fake.NewSimpleClientset()
-		c := controllers.NewVMController(clientset)
+		c := controllers.NewVMController(clientset, record.NewFakeRecorder(10))
 
 		vmi := libvmi.New(
 			libvmi.WithInterface(libvmi.InterfaceDeviceWithMasqueradeBinding()),
@@ -696,7 +707,7 @@ var _ = Describe("VM Network Controller", func() {
 
 	It("sync handles multiple NAD reference updates", func() {
 		clientset := fake.NewSimpleClientset()
-		c := controllers.NewVMController(clientset)
+		c := controllers.NewVMController(clientset, record.NewFakeRecorder(10))
 
 		By("Creating a new VM)")
 		vmi := libvmi.New(
@@ -747,7 +758,7 @@ var _ = Describe("VM Network Controller", func() {
 
 	It("sync preserves auto-injected Pod network", func() {
 		clientset := fake.NewSimpleClientset()
-		c := controllers.NewVMController(clientset)
+		c := controllers.NewVMController(clientset, record.NewFakeRecorder(10))
 
 		expectedIfaces := []v1.Interface{libvmi.InterfaceDeviceWithMasqueradeBinding()}
 		expectedNets := []v1.Network{*v1.DefaultPodNetwork()}
@@ -17,7 +17,7 @@
  *
  */
 
-package network
+package multiqueue
 
 import (
 	v1 "kubevirt.io/api/core/v1"
@@ -27,9 +27,12 @@ import (
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter/vcpu"
 )
 
-const MultiQueueMaxQueues = uint32(256)
+// MaxQueues is the maximum number of queues of a tap device.
+const MaxQueues = uint32(256)
 
-func NetworkQueuesCapacity(vmi *v1.VirtualMachineInstance) uint32 {
+// QueuesCapacity returns the number of queues of each virtio network interface of the VMI.
+// It is 0 when network interface multi-queue is not enabled.
+func QueuesCapacity(vmi *v1.VirtualMachineInstance) uint32 {
 	if !isTrue(vmi.Spec.Domain.Devices.NetworkInterfaceMultiQueue) {
 		return 0
 	}
@@ -37,9 +40,9 @@ func NetworkQueuesCapacity(vmi *v1.VirtualMachineInstance) uint32 {
 	cpuTopology := vcpu.GetCPUTopology(vmi)
 	queueNumber := vcpu.CalculateRequestedVCPUs(cpuTopology)
 
-	if queueNumber > MultiQueueMaxQueues {
-		log.Log.Infof("Capped the number of queues to be the current maximum of tap device queues: %d", MultiQueueMaxQueues)
-		queueNumber = MultiQueueMaxQueues
+	if queueNumber > MaxQueues {
+		log.Log.Infof("Capped the number of queues to be the current maximum of tap device queues: %d", MaxQueues)
+		queueNumber = MaxQueues
 	}
 	return queueNumber
 }
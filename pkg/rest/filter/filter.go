@@ -21,10 +21,14 @@ package filter
 
 import (
 	"net"
+	"strings"
+	"time"
 
 	restful "github.com/emicklei/go-restful/v3"
 
 	"kubevirt.io/client-go/log"
+
+	metrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-api"
 )
 
 func RequestLoggingFilter() restful.FilterFunction {
@@ -47,3 +51,44 @@ func RequestLoggingFilter() restful.FilterFunction {
 			Log("contentLength", resp.ContentLength())
 	}
 }
+
+func RequestMetricsFilter() restful.FilterFunction {
+	return func(req *restful.Request, resp *restful.Response, chain *restful.FilterChain) {
+		resource := requestResource(req.SelectedRoutePath())
+		verb := req.Request.Method
+
+		inFlight := metrics.NewRequestInFlight(resource, verb)
+		defer inFlight.Dec()
+
+		start := time.Now()
+		chain.ProcessFilter(req, resp)
+		metrics.ObserveRequestDuration(resource, verb, resp.StatusCode(), time.Since(start))
+	}
+}
+
+// requestResource returns the resource a route targets, keeping the label cardinality bounded.
+// The group version, the namespaces segment and the path parameters are dropped, so
+// /apis/subresources.kubevirt.io/v1/namespaces/{namespace}/virtualmachineinstances/{name}/console
+// becomes virtualmachineinstances/console.
+func requestResource(routePath string) string {
+	if routePath == "" {
+		return "unknown"
+	}
+
+	segments := strings.Split(strings.Trim(routePath, "/"), "/")
+	if len(segments) >= 3 && segments[0] == "apis" {
+		segments = segments[3:]
+	}
+
+	var resource []string
+	for _, segment := range segments {
+		if segment == "" || segment == "namespaces" || strings.HasPrefix(segment, "{") {
+			continue
+		}
+		resource = append(resource, segment)
+	}
+	if len(resource) == 0 {
+		return "discovery"
+	}
+	return strings.Join(resource, "/")
+}
@@ -54,6 +54,7 @@ import (
 
 	virtcontroller "kubevirt.io/kubevirt/pkg/controller"
 	"kubevirt.io/kubevirt/pkg/instancetype/revision"
+	metrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-controller"
 	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/testutils"
 )
@@ -1441,6 +1442,52 @@ var _ = Describe("Restore controller", func() {
 				Expect(*updateStatusCalls).To(Equal(1))
 			})
 
+			It("should not report the restore duration before the completed status is updated", func() {
+				r := createRestoreWithOwner()
+				r.CreationTimestamp = metav1.NewTime(timeStamp.Add(-time.Minute))
+				r.Status = &snapshotv1.VirtualMachineRestoreStatus{
+					Complete:           pointer.P(false),
+					DeletedDataVolumes: getDeletedDataVolumes(createModifiedVM()),
+					Conditions: []snapshotv1.Condition{
+						newProgressingCondition(corev1.ConditionTrue, "Updating target status"),
+						newReadyCondition(corev1.ConditionFalse, "Waiting for target update"),
+					},
+				}
+				addVolumeRestores(r)
+				for i := range r.Status.Restores {
+					r.Status.Restores[i].DataVolumeName = &r.Status.Restores[i].PersistentVolumeClaimName
+				}
+
+				vm := &kubevirtv1.VirtualMachine{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      vmName,
+						Namespace: testNamespace,
+						UID:       vmUID,
+						Annotations: map[string]string{
+							lastRestoreAnnotation: "restore-uid",
+						},
+					},
+				}
+
+				durationBefore, err := metrics.GetVMRestoreDuration(vmName, r.Name, testNamespace)
+				Expect(err).ToNot(HaveOccurred())
+
+				kubevirtClient.Fake.PrependReactor("update", "virtualmachinerestores", func(action testing.Action) (bool, runtime.Object, error) {
+					return true, nil, fmt.Errorf("conflict")
+				})
+
+				for _, pvc := range getRestorePVCs(r) {
+					pvc.Annotations["cdi.kubevirt.io/storage.populatedFor"] = pvc.Name
+					pvc.Status.Phase = corev1.ClaimBound
+					Expect(controller.PVCInformer.GetStore().Add(&pvc)).To(Succeed())
+				}
+
+				addVirtualMachineRestore(r)
+				Expect(controller.VMInformer.GetStore().Add(vm)).To(Succeed())
+				controller.processVMRestoreWorkItem()
+				Expect(metrics.GetVMRestoreDuration(vmName, r.Name, testNamespace)).To(Equal(durationBefore))
+			})
+
 			It("should update status if restore deleted after completion", func() {
 				r := createRestoreWithOwner()
 				r.DeletionTimestamp = timeFunc()
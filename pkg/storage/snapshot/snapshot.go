@@ -65,6 +65,8 @@ const (
 
 	vmSnapshotDeadlineExceededError = "snapshot deadline exceeded"
 
+	vmSnapshotDeadlineExceededReason = "DeadlineExceeded"
+
 	snapshotRetryInterval = 5 * time.Second
 
 	contentDeletionInterval = 5 * time.Second
@@ -800,8 +802,10 @@ func (ctrl *VMSnapshotController) updateSnapshotStatus(vmSnapshot *snapshotv1.Vi
 		vmSnapshotCpy.Status.Error = content.Status.Error
 	}
 
+	deadlineExceeded := false
 	// terminal phase 1 - failed
 	if vmSnapshotDeadlineExceeded(vmSnapshotCpy) {
+		deadlineExceeded = !vmSnapshotFailed(vmSnapshot)
 		vmSnapshotCpy.Status.Phase = snapshotv1.Failed
 		updateSnapshotCondition(vmSnapshotCpy, newFailureCondition(corev1.ConditionTrue, vmSnapshotDeadlineExceededError))
 		updateSnapshotCondition(vmSnapshotCpy, newProgressingCondition(corev1.ConditionFalse, "Operation failed"))
@@ -847,6 +851,10 @@ func (ctrl *VMSnapshotController) updateSnapshotStatus(vmSnapshot *snapshotv1.Vi
 		if _, err := ctrl.Client.VirtualMachineSnapshot(vmSnapshotCpy.Namespace).UpdateStatus(context.Background(), vmSnapshotCpy, metav1.UpdateOptions{}); err != nil {
 			return nil, err
 		}
+		// Counted once the failure is persisted, a failed update is retried with the same transition
+		if deadlineExceeded {
+			metrics.HandleFailedVMSnapshot(vmSnapshotCpy, vmSnapshotDeadlineExceededReason)
+		}
 		return vmSnapshotCpy, nil
 	}
 
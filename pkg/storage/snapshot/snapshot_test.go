@@ -61,6 +61,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
 	virtcontroller "kubevirt.io/kubevirt/pkg/controller"
 	"kubevirt.io/kubevirt/pkg/instancetype/revision"
+	metrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-controller"
 	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	"kubevirt.io/kubevirt/pkg/util"
@@ -1373,12 +1374,39 @@ var _ = Describe("Snapshot controlleer", func() {
 					newReadyCondition(corev1.ConditionFalse, "Not ready"),
 				}
 
+				failedBefore, err := metrics.GetVMSnapshotFailedCount(vmName, testNamespace, vmSnapshotDeadlineExceededReason)
+				Expect(err).ToNot(HaveOccurred())
+
 				contentDeletes := expectVMSnapshotContentDelete(vmSnapshotClient, vmSnapshotContent.Name)
 				updateStatusCalls := expectVMSnapshotUpdateStatus(vmSnapshotClient, updatedSnapshot)
 
 				controller.processVMSnapshotWorkItem()
 				Expect(*updateStatusCalls).To(Equal(1))
 				Expect(*contentDeletes).To(Equal(1))
+				Expect(metrics.GetVMSnapshotFailedCount(vmName, testNamespace, vmSnapshotDeadlineExceededReason)).To(Equal(failedBefore + 1))
+			})
+
+			It("should not count a failed VirtualMachineSnapshot before its status is updated", func() {
+				vmSnapshot := createVMSnapshotInProgress()
+				negativeDeadline, _ := time.ParseDuration("-1m")
+				vmSnapshot.Spec.FailureDeadline = &metav1.Duration{Duration: negativeDeadline}
+				vm := createLockedVM()
+				vmSnapshotContent := createVMSnapshotContent()
+
+				vmSnapshotContentSource.Add(vmSnapshotContent)
+				vmSource.Add(vm)
+				addVirtualMachineSnapshot(vmSnapshot)
+
+				failedBefore, err := metrics.GetVMSnapshotFailedCount(vmName, testNamespace, vmSnapshotDeadlineExceededReason)
+				Expect(err).ToNot(HaveOccurred())
+
+				expectVMSnapshotContentDelete(vmSnapshotClient, vmSnapshotContent.Name)
+				vmSnapshotClient.Fake.PrependReactor("update", "virtualmachinesnapshots", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+					return true, nil, fmt.Errorf("conflict")
+				})
+
+				controller.processVMSnapshotWorkItem()
+				Expect(metrics.GetVMSnapshotFailedCount(vmName, testNamespace, vmSnapshotDeadlineExceededReason)).To(Equal(failedBefore))
 			})
 
 			It("should create VolumeSnapshot", func() {
@@ -38,10 +38,14 @@ import (
 	restful "github.com/emicklei/go-restful/v3"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	flag "github.com/spf13/pflag"
+	k8sv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/kubernetes/scheme"
+	k8coresv1 "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
 	certificate2 "k8s.io/client-go/util/certificate"
 	"k8s.io/client-go/util/flowcontrol"
 	aggregatorclient "k8s.io/kube-aggregator/pkg/client/clientset_generated/clientset"
@@ -58,6 +62,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/certificates/bootstrap"
 	"kubevirt.io/kubevirt/pkg/controller"
 	"kubevirt.io/kubevirt/pkg/healthz"
+	metricscatalog "kubevirt.io/kubevirt/pkg/monitoring/metrics/common/catalog"
 	clientmetrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/common/client"
 	metrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-api"
 	"kubevirt.io/kubevirt/pkg/monitoring/profiler"
@@ -219,9 +224,22 @@ func subresourceAPIGroup() metav1.APIGroup {
 	return apiGroup
 }
 
+func (app *virtAPIApp) newEventRecorder() record.EventRecorder {
+	// virtCli is nil when the API is only composed to generate the openapi spec
+	if app.virtCli == nil {
+		return nil
+	}
+
+	eventBroadcaster := record.NewBroadcaster()
+	eventBroadcaster.StartRecordingToSink(&k8coresv1.EventSinkImpl{Interface: app.virtCli.CoreV1().Events(k8sv1.NamespaceAll)})
+	return eventBroadcaster.NewRecorder(scheme.Scheme, k8sv1.EventSource{Component: "virt-api"})
+}
+
 func (app *virtAPIApp) composeSubresources() {
 	var subwss []*restful.WebService
 
+	operationRecorder := rest.NewVMOperationRecorder(app.newEventRecorder())
+
 	for _, version := range v1.SubresourceGroupVersions {
 		subresourcesvmGVR := schema.GroupVersionResource{Group: version.Group, Version: version.Version, Resource: "virtualmachines"}
 		subresourcesvmiGVR := schema.GroupVersionResource{Group: version.Group, Version: version.Version, Resource: "virtualmachineinstances"}
@@ -234,7 +252,7 @@ func (app *virtAPIApp) composeSubresources() {
 		subresourceApp := rest.NewSubresourceAPIApp(app.virtCli, app.consoleServerPort, app.handlerTLSConfiguration, app.clusterConfig)
 
 		restartRouteBuilder := subws.PUT(definitions.NamespacedResourcePath(subresourcesvmGVR)+definitions.SubResourcePath("restart")).
-			To(subresourceApp.RestartVMRequestHandler).
+			To(operationRecorder.Handler(v1.VirtualMachineGroupVersionKind, "restart", subresourceApp.RestartVMRequestHandler)).
 			Consumes(mime.MIME_ANY).
 			Reads(v1.RestartOptions{}).
 			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
@@ -247,7 +265,7 @@ func (app *virtAPIApp) composeSubresources() {
 		subws.Route(restartRouteBuilder)
 
 		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmGVR)+definitions.SubResourcePath("migrate")).
-			To(subresourceApp.MigrateVMRequestHandler).
+			To(operationRecorder.Handler(v1.VirtualMachineGroupVersionKind, "migrate", subresourceApp.MigrateVMRequestHandler)).
 			Consumes(mime.MIME_ANY).
 			Reads(v1.MigrateOptions{}).
 			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
@@ -258,7 +276,7 @@ func (app *virtAPIApp) composeSubresources() {
 			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, ""))
 
 		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmGVR)+definitions.SubResourcePath("start")).
-			To(subresourceApp.StartVMRequestHandler).
+			To(operationRecorder.Handler(v1.VirtualMachineGroupVersionKind, "start", subresourceApp.StartVMRequestHandler)).
 			Consumes(mime.MIME_ANY).
 			Reads(v1.StartOptions{}).
 			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
@@ -269,7 +287,7 @@ func (app *virtAPIApp) composeSubresources() {
 			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, ""))
 
 		stopRouteBuilder := subws.PUT(definitions.NamespacedResourcePath(subresourcesvmGVR)+definitions.SubResourcePath("stop")).
-			To(subresourceApp.StopVMRequestHandler).
+			To(operationRecorder.Handler(v1.VirtualMachineGroupVersionKind, "stop", subresourceApp.StopVMRequestHandler)).
 			Consumes(mime.MIME_ANY).
 			Reads(v1.StopOptions{}).
 			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
@@ -326,7 +344,7 @@ func (app *virtAPIApp) composeSubresources() {
 			Returns(http.StatusInternalServerError, httpStatusInternalServerError, ""))
 
 		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("pause")).
-			To(subresourceApp.PauseVMIRequestHandler).
+			To(operationRecorder.Handler(v1.VirtualMachineInstanceGroupVersionKind, "pause", subresourceApp.PauseVMIRequestHandler)).
 			Consumes(mime.MIME_ANY).
 			Reads(v1.PauseOptions{}).
 			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
@@ -337,7 +355,7 @@ func (app *virtAPIApp) composeSubresources() {
 			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, ""))
 
 		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("unpause")).
-			To(subresourceApp.UnpauseVMIRequestHandler). // handles VMIs as well
+			To(operationRecorder.Handler(v1.VirtualMachineInstanceGroupVersionKind, "unpause", subresourceApp.UnpauseVMIRequestHandler)). // handles VMIs as well
 			Consumes(mime.MIME_ANY).
 			Reads(v1.UnpauseOptions{}).
 			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
@@ -480,6 +498,16 @@ func (app *virtAPIApp) composeSubresources() {
 			Writes(v1.VirtualMachineInstanceFileSystemList{}).
 			Returns(http.StatusOK, "OK", v1.VirtualMachineInstanceFileSystemList{}))
 
+		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("migrationfeasibility")).
+			To(subresourceApp.MigrationFeasibility).
+			Consumes(restful.MIME_JSON).
+			Produces(restful.MIME_JSON).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"MigrationFeasibility").
+			Doc("Estimate whether a live migration of the VirtualMachineInstance is expected to complete").
+			Writes(v1.VirtualMachineInstanceMigrationFeasibility{}).
+			Returns(http.StatusOK, "OK", v1.VirtualMachineInstanceMigrationFeasibility{}))
+
 		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("objectgraph")).
 			To(subresourceApp.VMIObjectGraph).
 			Consumes(restful.MIME_JSON).
@@ -753,6 +781,10 @@ func (app *virtAPIApp) composeSubresources() {
 						Name:       "virtualmachineinstances/filesystemlist",
 						Namespaced: true,
 					},
+					{
+						Name:       "virtualmachineinstances/migrationfeasibility",
+						Namespaced: true,
+					},
 					{
 						Name:       "virtualmachineinstances/addvolume",
 						Namespaced: true,
@@ -898,6 +930,7 @@ func (app *virtAPIApp) Compose() {
 	app.composeSubresources()
 
 	restful.Filter(filter.RequestLoggingFilter())
+	restful.Filter(filter.RequestMetricsFilter())
 	restful.Filter(restful.OPTIONSFilter())
 	restful.Filter(func(req *restful.Request, resp *restful.Response, chain *restful.FilterChain) {
 		allowed, reason, err := app.authorizor.Authorize(req)
@@ -1108,6 +1141,7 @@ func (app *virtAPIApp) startTLS(informerFactory controller.KubeInformerFactory)
 	app.Compose()
 
 	http.Handle("/metrics", promhttp.Handler())
+	http.Handle("/metrics/docs", metricscatalog.Handler())
 	server := &http.Server{
 		Addr:      fmt.Sprintf("%s:%d", app.BindAddress, app.Port),
 		TLSConfig: app.tlsConfig,
@@ -1210,6 +1244,7 @@ func (app *virtAPIApp) Run() {
 	if err != nil {
 		panic(err)
 	}
+	metrics.SetDurationHistogramBuckets(app.clusterConfig.GetDurationHistogramBuckets())
 	app.hasCDIDataSource = app.clusterConfig.HasDataSourceAPI()
 	app.clusterConfig.SetConfigModifiedCallback(app.configModificationCallback)
 	app.clusterConfig.SetConfigModifiedCallback(app.shouldChangeLogVerbosity)
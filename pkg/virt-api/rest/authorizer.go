@@ -42,6 +42,9 @@ const (
 	groupHeader           = "X-Remote-Group"
 	userExtraHeaderPrefix = "X-Remote-Extra-"
 
+	// userNameAttribute is the request attribute holding the name of the authorized user
+	userNameAttribute = "kubevirt.io/user-name"
+
 	namespacedResourceAttributesMinParts  = 9
 	namespacedResourceBaseAttributesParts = 7
 )
@@ -353,6 +356,7 @@ func (a *authorizor) Authorize(req *restful.Request) (bool, string, error) {
 	}
 
 	if result.Status.Allowed {
+		req.SetAttribute(userNameAttribute, r.Spec.User)
 		return true, "", nil
 	}
 
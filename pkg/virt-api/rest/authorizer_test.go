@@ -44,8 +44,7 @@ var _ = Describe("Authorizer", func() {
 		)
 
 		BeforeEach(func() {
-			req = &restful.Request{}
-			req.Request = &http.Request{}
+			req = restful.NewRequest(&http.Request{})
 			req.Request.URL = &url.URL{}
 			req.Request.Header = make(map[string][]string)
 			req.Request.Header[userHeader] = []string{"user"}
@@ -131,6 +130,7 @@ var _ = Describe("Authorizer", func() {
 					result, _, err := app.Authorize(req)
 					Expect(err).ToNot(HaveOccurred())
 					Expect(result).To(BeTrue())
+					Expect(req.Attribute(userNameAttribute)).To(Equal("user"))
 				})
 			})
 
@@ -52,6 +52,7 @@ func (app *SubresourceAPIApp) StartVMRequestHandler(request *restful.Request, re
 		writeError(statusErr, response)
 		return
 	}
+	setInvolvedObjectUID(request, vm.UID)
 
 	vmi, err := app.virtCli.VirtualMachineInstance(namespace).Get(context.Background(), name, metav1.GetOptions{})
 	if err != nil && !errors.IsNotFound(err) {
@@ -185,6 +186,7 @@ func (app *SubresourceAPIApp) StopVMRequestHandler(request *restful.Request, res
 		writeError(statusErr, response)
 		return
 	}
+	setInvolvedObjectUID(request, vm.UID)
 
 	runStrategy, err := vm.RunStrategy()
 	if err != nil {
@@ -443,6 +445,7 @@ func (app *SubresourceAPIApp) RestartVMRequestHandler(request *restful.Request,
 		writeError(statusErr, response)
 		return
 	}
+	setInvolvedObjectUID(request, vm.UID)
 	if controller.NewVirtualMachineConditionManager().HasConditionWithStatus(vm,
 		v1.VirtualMachineConditionType(v1.VirtualMachineInstanceVolumesChange), k8sv1.ConditionTrue) {
 		writeError(errors.NewConflict(v1.Resource("virtualmachine"), name, fmt.Errorf(volumeMigrationManualRecoveryRequiredErr)), response)
@@ -551,11 +554,12 @@ func (app *SubresourceAPIApp) MigrateVMRequestHandler(request *restful.Request,
 			return
 		}
 	}
-	_, err := app.fetchVirtualMachine(name, namespace)
+	vm, err := app.fetchVirtualMachine(name, namespace)
 	if err != nil {
 		writeError(err, response)
 		return
 	}
+	setInvolvedObjectUID(request, vm.UID)
 
 	vmi, err := app.FetchVirtualMachineInstance(namespace, name)
 	if err != nil {
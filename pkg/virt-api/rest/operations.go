@@ -0,0 +1,158 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/emicklei/go-restful/v3"
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+
+	apimetrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-api"
+)
+
+const (
+	initiatorUser           = "user"
+	initiatorServiceAccount = "serviceaccount"
+	initiatorSystem         = "system"
+
+	serviceAccountUserPrefix = "system:serviceaccount:"
+	systemUserPrefix         = "system:"
+
+	// involvedObjectUIDAttribute is the request attribute holding the UID of the object the
+	// subresource handler fetched, so the operation event can refer to it without another lookup
+	involvedObjectUIDAttribute = "kubevirt.io/involved-object-uid"
+
+	// maxDryRunPeekSize bounds how much of a request body is read to look for the dryRun
+	// option. The lifecycle subresource options are much smaller.
+	maxDryRunPeekSize = 16 * 1024
+)
+
+// VMOperationRecorder counts the lifecycle operations accepted by the subresource API
+// and records who requested them as events on the VirtualMachine or VirtualMachineInstance.
+type VMOperationRecorder struct {
+	recorder record.EventRecorder
+}
+
+func NewVMOperationRecorder(recorder record.EventRecorder) *VMOperationRecorder {
+	return &VMOperationRecorder{
+		recorder: recorder,
+	}
+}
+
+// Handler wraps the handler of a lifecycle subresource of the given kind. Requests that
+// are rejected or only dry-run are not recorded.
+func (r *VMOperationRecorder) Handler(gvk schema.GroupVersionKind, operation string, handler restful.RouteFunction) restful.RouteFunction {
+	return func(request *restful.Request, response *restful.Response) {
+		dryRun := isDryRunRequest(request)
+
+		handler(request, response)
+
+		if dryRun || response.StatusCode() >= http.StatusMultipleChoices {
+			return
+		}
+
+		userName, _ := request.Attribute(userNameAttribute).(string)
+		uid, _ := request.Attribute(involvedObjectUIDAttribute).(types.UID)
+		apimetrics.NewVMOperation(operation, getInitiator(userName))
+		r.recordEvent(gvk, request.PathParameter("namespace"), request.PathParameter("name"), uid, operation, userName)
+	}
+}
+
+// setInvolvedObjectUID stores the UID of the object a subresource request is about.
+func setInvolvedObjectUID(request *restful.Request, uid types.UID) {
+	request.SetAttribute(involvedObjectUIDAttribute, uid)
+}
+
+// recordEvent refers to the object by its path parameters and the UID the wrapped handler
+// stored, so recording the event does not need to fetch it from the API server.
+func (r *VMOperationRecorder) recordEvent(gvk schema.GroupVersionKind, namespace, name string, uid types.UID, operation, userName string) {
+	if r.recorder == nil {
+		return
+	}
+
+	object := &k8sv1.ObjectReference{
+		APIVersion: gvk.GroupVersion().String(),
+		Kind:       gvk.Kind,
+		Namespace:  namespace,
+		Name:       name,
+		UID:        uid,
+	}
+	if userName == "" {
+		userName = "unknown user"
+	}
+	r.recorder.Eventf(object, k8sv1.EventTypeNormal, operationEventReason(operation),
+		"%s requested by %s", operation, userName)
+}
+
+func operationEventReason(operation string) string {
+	if operation == "" {
+		return "OperationRequested"
+	}
+	return fmt.Sprintf("%s%sRequested", strings.ToUpper(operation[:1]), operation[1:])
+}
+
+// getInitiator classifies the user of a request. The user name itself is only
+// part of the event, to keep the cardinality of the metric bounded.
+func getInitiator(userName string) string {
+	switch {
+	case strings.HasPrefix(userName, serviceAccountUserPrefix):
+		return initiatorServiceAccount
+	case strings.HasPrefix(userName, systemUserPrefix):
+		return initiatorSystem
+	default:
+		return initiatorUser
+	}
+}
+
+// isDryRunRequest reads the dryRun option shared by the lifecycle subresource options
+// and restores the request body for the wrapped handler.
+func isDryRunRequest(request *restful.Request) bool {
+	if request.Request.Body == nil {
+		return false
+	}
+
+	original := request.Request.Body
+	body, err := io.ReadAll(io.LimitReader(original, maxDryRunPeekSize+1))
+	// The part that was not read is passed on untouched
+	request.Request.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(body), original), original}
+	if err != nil || len(body) == 0 || len(body) > maxDryRunPeekSize {
+		return false
+	}
+
+	options := struct {
+		DryRun []string `json:"dryRun,omitempty"`
+	}{}
+	if err := json.Unmarshal(body, &options); err != nil {
+		return false
+	}
+	return len(options.DryRun) > 0
+}
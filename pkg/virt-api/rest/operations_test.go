@@ -0,0 +1,164 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/emicklei/go-restful/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+var _ = Describe("VM operation recorder", func() {
+	const testVMName = "testvm"
+
+	var (
+		request       *restful.Request
+		response      *restful.Response
+		eventRecorder *record.FakeRecorder
+		opRecorder    *VMOperationRecorder
+	)
+
+	newRequest := func(body string) *restful.Request {
+		req := restful.NewRequest(&http.Request{Body: io.NopCloser(bytes.NewBufferString(body))})
+		req.PathParameters()["namespace"] = metav1.NamespaceDefault
+		req.PathParameters()["name"] = testVMName
+		req.SetAttribute(userNameAttribute, "jdoe")
+		return req
+	}
+
+	BeforeEach(func() {
+		request = newRequest("{}")
+		response = restful.NewResponse(httptest.NewRecorder())
+		eventRecorder = record.NewFakeRecorder(10)
+		eventRecorder.IncludeObject = true
+
+		opRecorder = NewVMOperationRecorder(eventRecorder)
+	})
+
+	It("should record an event on the VM for an accepted operation", func() {
+		handler := opRecorder.Handler(v1.VirtualMachineGroupVersionKind, "restart", func(_ *restful.Request, response *restful.Response) {
+			response.WriteHeader(http.StatusAccepted)
+		})
+		handler(request, response)
+
+		Expect(eventRecorder.Events).To(Receive(Equal(
+			"Normal RestartRequested restart requested by jdoe involvedObject{kind=VirtualMachine,apiVersion=kubevirt.io/v1}")))
+	})
+
+	It("should record an event on the VMI for a VMI operation", func() {
+		handler := opRecorder.Handler(v1.VirtualMachineInstanceGroupVersionKind, "pause", func(_ *restful.Request, response *restful.Response) {
+			response.WriteHeader(http.StatusOK)
+		})
+		handler(request, response)
+
+		Expect(eventRecorder.Events).To(Receive(Equal(
+			"Normal PauseRequested pause requested by jdoe involvedObject{kind=VirtualMachineInstance,apiVersion=kubevirt.io/v1}")))
+	})
+
+	It("should refer to the object fetched by the handler by its UID", func() {
+		const vmUID = types.UID("vm-uid")
+		objectRecorder := &involvedObjectRecorder{FakeRecorder: eventRecorder}
+		opRecorder = NewVMOperationRecorder(objectRecorder)
+
+		handler := opRecorder.Handler(v1.VirtualMachineGroupVersionKind, "stop", func(request *restful.Request, response *restful.Response) {
+			setInvolvedObjectUID(request, vmUID)
+			response.WriteHeader(http.StatusAccepted)
+		})
+		handler(request, response)
+
+		Expect(objectRecorder.objects).To(ConsistOf(&k8sv1.ObjectReference{
+			APIVersion: v1.VirtualMachineGroupVersionKind.GroupVersion().String(),
+			Kind:       v1.VirtualMachineGroupVersionKind.Kind,
+			Namespace:  metav1.NamespaceDefault,
+			Name:       testVMName,
+			UID:        vmUID,
+		}))
+	})
+
+	It("should not record rejected operations", func() {
+		handler := opRecorder.Handler(v1.VirtualMachineGroupVersionKind, "stop", func(_ *restful.Request, response *restful.Response) {
+			response.WriteHeader(http.StatusConflict)
+		})
+		handler(request, response)
+
+		Expect(eventRecorder.Events).ToNot(Receive())
+	})
+
+	It("should not record dry-run operations and keep the body for the handler", func() {
+		const body = `{"dryRun":["All"]}`
+		request = newRequest(body)
+
+		var handlerBody []byte
+		handler := opRecorder.Handler(v1.VirtualMachineGroupVersionKind, "start", func(request *restful.Request, response *restful.Response) {
+			var err error
+			handlerBody, err = io.ReadAll(request.Request.Body)
+			Expect(err).ToNot(HaveOccurred())
+			response.WriteHeader(http.StatusAccepted)
+		})
+		handler(request, response)
+
+		Expect(string(handlerBody)).To(Equal(body))
+		Expect(eventRecorder.Events).ToNot(Receive())
+	})
+
+	It("should only peek into a bounded part of the body and keep all of it for the handler", func() {
+		body := `{"dryRun":["All"],"padding":"` + strings.Repeat("x", maxDryRunPeekSize) + `"}`
+		request = newRequest(body)
+
+		Expect(isDryRunRequest(request)).To(BeFalse())
+		handlerBody, err := io.ReadAll(request.Request.Body)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(handlerBody)).To(Equal(body))
+	})
+
+	DescribeTable("should classify the initiator", func(userName, expected string) {
+		Expect(getInitiator(userName)).To(Equal(expected))
+	},
+		Entry("for a regular user", "jdoe", initiatorUser),
+		Entry("for a service account", "system:serviceaccount:default:automation", initiatorServiceAccount),
+		Entry("for a system user", "system:kube-controller-manager", initiatorSystem),
+	)
+})
+
+// involvedObjectRecorder remembers the objects the events are recorded on, the FakeRecorder
+// only reports their kind and API version.
+type involvedObjectRecorder struct {
+	*record.FakeRecorder
+	objects []runtime.Object
+}
+
+func (r *involvedObjectRecorder) Eventf(object runtime.Object, eventtype, reason, messageFmt string, args ...interface{}) {
+	r.objects = append(r.objects, object)
+	r.FakeRecorder.Eventf(object, eventtype, reason, messageFmt, args...)
+}
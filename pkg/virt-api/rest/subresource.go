@@ -125,6 +125,7 @@ func (app *SubresourceAPIApp) prepareConnection(request *restful.Request, valida
 	if statusError != nil {
 		return
 	}
+	setInvolvedObjectUID(request, vmi.UID)
 
 	url, conn, statusError = app.getVirtHandlerFor(vmi, getVirtHandlerURL)
 	if statusError != nil {
@@ -352,6 +353,22 @@ func (app *SubresourceAPIApp) FilesystemList(request *restful.Request, response
 	app.httpGetRequestHandler(request, response, validate, getURL, v1.VirtualMachineInstanceFileSystemList{})
 }
 
+// MigrationFeasibility handles the subresource for estimating whether a live migration
+// of the VMI is expected to complete, based on the dirty rate virt-handler samples from libvirt
+func (app *SubresourceAPIApp) MigrationFeasibility(request *restful.Request, response *restful.Response) {
+	validate := func(vmi *v1.VirtualMachineInstance) *errors.StatusError {
+		if vmi == nil || vmi.Status.Phase != v1.Running {
+			return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf(vmiNotRunning))
+		}
+		return nil
+	}
+	getURL := func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
+		return conn.MigrationFeasibilityURI(vmi)
+	}
+
+	app.httpGetRequestHandler(request, response, validate, getURL, v1.VirtualMachineInstanceMigrationFeasibility{})
+}
+
 func decodeBody(request *restful.Request, bodyStruct interface{}) *errors.StatusError {
 	err := yaml.NewYAMLOrJSONDecoder(request.Request.Body, 1024).Decode(&bodyStruct)
 	switch err {
@@ -1341,6 +1341,27 @@ var _ = Describe("VirtualMachineInstance Subresources", func() {
 			Expect(backend.ReceivedRequests()).To(HaveLen(1))
 		})
 
+		It("Should store the UID of the paused VMI for the operation event", func() {
+			backend.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/v1/namespaces/default/virtualmachineinstances/testvmi/pause"),
+					ghttp.RespondWith(http.StatusOK, ""),
+				),
+			)
+			const vmiUID = types.UID("vmi-uid")
+			expectVMI(Running, UnPaused, func(vmi *v1.VirtualMachineInstance) {
+				vmi.UID = vmiUID
+			})
+
+			bytesRepresentation, _ := json.Marshal(&v1.PauseOptions{})
+			request.Request.Body = io.NopCloser(bytes.NewReader(bytesRepresentation))
+
+			app.PauseVMIRequestHandler(request, response)
+
+			Expect(response.StatusCode()).To(Equal(http.StatusOK))
+			Expect(request.Attribute(involvedObjectUIDAttribute)).To(Equal(vmiUID))
+		})
+
 		DescribeTable("Should fail unpausing due to VMI state", func(running bool, paused bool, unpauseOptions *v1.UnpauseOptions, expectedError string) {
 
 			expectVMI(running, paused)
@@ -1425,6 +1446,7 @@ var _ = Describe("VirtualMachineInstance Subresources", func() {
 				ObjectMeta: k8smetav1.ObjectMeta{
 					Name:      testVMName,
 					Namespace: k8smetav1.NamespaceDefault,
+					UID:       uuid.NewUUID(),
 				},
 				Spec: v1.VirtualMachineSpec{
 					Running:  pointer.P(NotRunning),
@@ -1449,6 +1471,7 @@ var _ = Describe("VirtualMachineInstance Subresources", func() {
 
 			Expect(response.Error()).ToNot(HaveOccurred())
 			Expect(response.StatusCode()).To(Equal(http.StatusAccepted))
+			Expect(request.Attribute(involvedObjectUIDAttribute)).To(Equal(vm.UID))
 		},
 			Entry("with default", &v1.StartOptions{Paused: Paused}),
 			Entry("with dry-run option", &v1.StartOptions{Paused: Paused, DryRun: withDryRun()}),
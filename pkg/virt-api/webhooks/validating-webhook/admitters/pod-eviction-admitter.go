@@ -37,6 +37,7 @@ import (
 	kubevirt "kubevirt.io/client-go/kubevirt"
 
 	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	metrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-api"
 	"kubevirt.io/kubevirt/pkg/util/migrations"
 	validating_webhooks "kubevirt.io/kubevirt/pkg/util/webhooks/validating-webhooks"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
@@ -51,6 +52,14 @@ const (
 	requestedByDeschedulerValue = "sigs.k8s.io/descheduler"
 )
 
+// Reasons reported by kubevirt_vmi_eviction_blocked_total
+const (
+	evictionBlockedHotplugPod           = "HotplugPod"
+	evictionBlockedNotMigratable        = "NotMigratable"
+	evictionBlockedEvacuationInProgress = "EvacuationInProgress"
+	evictionBlockedMigrationTargetPod   = "MigrationTargetPod"
+)
+
 type PodEvictionAdmitter struct {
 	clusterConfig *virtconfig.ClusterConfig
 	kubeClient    kubernetes.Interface
@@ -121,6 +130,7 @@ func (admitter *PodEvictionAdmitter) admitHotplugPod(ctx context.Context, pod *k
 		return validating_webhooks.NewPassingAdmissionResponse()
 	}
 
+	metrics.VMIEvictionBlocked(evictionBlockedHotplugPod)
 	return denied(fmt.Sprintf("cannot evict hotplug pod: %s associated with running vmi: %s in namespace %s", pod.Name, vmiName, pod.Namespace))
 }
 
@@ -146,6 +156,7 @@ func (admitter *PodEvictionAdmitter) admitLauncherPod(ctx context.Context, ar *a
 	switch *evictionStrategy {
 	case virtv1.EvictionStrategyLiveMigrate:
 		if !vmi.IsMigratable() {
+			metrics.VMIEvictionBlocked(evictionBlockedNotMigratable)
 			return denied(fmt.Sprintf("VMI %s is configured with an eviction strategy but is not live-migratable", vmi.Name))
 		}
 		markForEviction = true
@@ -164,9 +175,11 @@ func (admitter *PodEvictionAdmitter) admitLauncherPod(ctx context.Context, ar *a
 	// This message format is expected from descheduler.
 	const evictionFmt = "Eviction triggered evacuation of VMI \"%s/%s\""
 	if vmi.IsMarkedForEviction() {
+		metrics.VMIEvictionBlocked(evictionBlockedEvacuationInProgress)
 		return denied(fmt.Sprintf("Evacuation in progress: "+evictionFmt, vmi.Namespace, vmi.Name))
 	}
 	if vmi.Status.NodeName != pod.Spec.NodeName {
+		metrics.VMIEvictionBlocked(evictionBlockedMigrationTargetPod)
 		return denied("Eviction request for target Pod")
 	}
 	evictionObject := policyv1.Eviction{}
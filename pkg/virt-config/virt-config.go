@@ -28,6 +28,7 @@ import (
 
 	k8sv1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	v1 "kubevirt.io/api/core/v1"
 
@@ -462,6 +463,14 @@ func (c *ClusterConfig) GetMaxHotplugRatio() uint32 {
 	return liveConfig.MaxHotplugRatio
 }
 
+func (c *ClusterConfig) GetMaxEphemeralHotplugVolumes() *uint32 {
+	return c.GetConfig().MaxEphemeralHotplugVolumes
+}
+
+func (c *ClusterConfig) GetDurationHistogramBuckets() []metav1.Duration {
+	return c.GetConfig().DurationHistogramBuckets
+}
+
 func (c *ClusterConfig) IsVMRolloutStrategyLiveUpdate() bool {
 	liveConfig := c.GetConfig().VMRolloutStrategy
 	return liveConfig == nil || *liveConfig == v1.VMRolloutStrategyLiveUpdate
@@ -83,6 +83,7 @@ import (
 	clusterutil "kubevirt.io/kubevirt/pkg/util/cluster"
 
 	instancetypecontroller "kubevirt.io/kubevirt/pkg/instancetype/controller/vm"
+	metricscatalog "kubevirt.io/kubevirt/pkg/monitoring/metrics/common/catalog"
 	clientmetrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/common/client"
 	metrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-controller"
 	"kubevirt.io/kubevirt/pkg/service"
@@ -476,6 +477,18 @@ func Execute() {
 	); err != nil {
 		golog.Fatal(err)
 	}
+	metrics.SetCollectorsInformersSynced(
+		app.migrationInformer.HasSynced,
+		app.kvPodInformer.HasSynced,
+		app.vmInformer.HasSynced,
+		app.vmiInformer.HasSynced,
+		app.persistentVolumeClaimInformer.HasSynced,
+		app.instancetypeInformer.HasSynced,
+		app.clusterInstancetypeInformer.HasSynced,
+		app.preferenceInformer.HasSynced,
+		app.clusterPreferenceInformer.HasSynced,
+		app.controllerRevisionInformer.HasSynced,
+	)
 
 	app.initCommon()
 	app.initReplicaSet()
@@ -550,6 +563,7 @@ func (vca *VirtControllerApp) Run() {
 		httpLogger := logger.With("service", "http")
 		_ = httpLogger.Level(log.INFO).Log("action", "listening", "interface", vca.BindAddress, "port", vca.Port)
 		http.Handle("/metrics", promhttp.Handler())
+		http.Handle("/metrics/docs", metricscatalog.Handler())
 		server := http.Server{
 			Addr:      vca.Address(),
 			Handler:   http.DefaultServeMux,
@@ -592,6 +606,18 @@ func (vca *VirtControllerApp) onStartedLeading() func(ctx context.Context) {
 			golog.Fatalf("failed to add vmi phase transition handler: %v", err)
 		}
 
+		if err := metrics.AddVMIHotplugHandlers(vca.vmiInformer); err != nil {
+			golog.Fatalf("failed to add vmi hotplug handlers: %v", err)
+		}
+
+		if err := metrics.AddVMIProbeHandlers(vca.vmiInformer); err != nil {
+			golog.Fatalf("failed to add vmi probe handlers: %v", err)
+		}
+
+		if err := metrics.AddVMIOOMHandlers(vca.vmiInformer, vca.kvPodInformer); err != nil {
+			golog.Fatalf("failed to add vmi oom handlers: %v", err)
+		}
+
 		if vca.migrationInformer == nil {
 			vca.migrationInformer = vca.informerFactory.VirtualMachineInstanceMigration()
 			metrics.UpdateVMIMigrationInformer(vca.migrationInformer.GetIndexer())
@@ -703,6 +729,7 @@ func (vca *VirtControllerApp) initCommon() {
 		vca.cdiInformer,
 		vca.cdiConfigInformer,
 		vca.kubeVirtInformer,
+		vca.namespaceInformer,
 		vca.clusterConfig,
 		topologyHinter,
 		netAnnotationsGenerator,
@@ -797,6 +824,7 @@ func (vca *VirtControllerApp) initVirtualMachines() {
 		vca.clusterConfig,
 		netcontrollers.NewVMController(
 			vca.clientSet.GeneratedKubeVirtClient(),
+			recorder,
 		),
 		vm.NewFirmwareController(vca.clientSet.GeneratedKubeVirtClient()),
 		instancetypecontroller.New(
@@ -135,6 +135,7 @@ var _ = Describe("Application", func() {
 		var qemuGid int64 = 107
 
 		app.vmiInformer = vmiInformer
+		app.kvPodInformer = podInformer
 		app.nodeTopologyUpdater = topologyUpdater
 		app.informerFactory = controller.NewKubeInformerFactory(nil, nil, nil, "test")
 		app.evacuationController, _ = evacuation.NewEvacuationController(vmiInformer, migrationInformer, nodeInformer, podInformer, recorder, virtClient, config)
@@ -154,6 +155,7 @@ var _ = Describe("Application", func() {
 			cdiInformer,
 			cdiConfigInformer,
 			kvInformer,
+			namespaceInformer,
 			config,
 			topology.NewTopologyHinter(&cache.FakeCustomStore{}, &cache.FakeCustomStore{}, nil),
 			nil,
@@ -25,6 +25,7 @@ import (
 	"errors"
 	"fmt"
 	"maps"
+	"regexp"
 	"slices"
 	"strconv"
 	"strings"
@@ -50,6 +51,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
 	"kubevirt.io/kubevirt/pkg/controller"
 	metrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/common/vmisync"
+	virtcontrollermetrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-controller"
 	"kubevirt.io/kubevirt/pkg/pointer"
 	backendstorage "kubevirt.io/kubevirt/pkg/storage/backend-storage"
 	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
@@ -179,6 +181,9 @@ func (c *Controller) sync(vmi *virtv1.VirtualMachineInstance, pod *k8sv1.Pod, da
 		}
 		if err != nil {
 			c.recorder.Eventf(vmi, k8sv1.EventTypeWarning, controller.FailedCreatePodReason, "Error creating pod: %v", err)
+			if blockedReason := podCreationBlockedReason(err.Error()); blockedReason != "" {
+				virtcontrollermetrics.VMICreationBlocked(blockedReason)
+			}
 			return common.NewSyncError(fmt.Errorf("failed to create virtual machine pod: %v", err), controller.FailedCreatePodReason), nil
 		}
 		c.recorder.Eventf(vmi, k8sv1.EventTypeNormal, controller.SuccessfulCreatePodReason, "Created virtual machine pod %s", pod.Name)
@@ -585,6 +590,8 @@ func (c *Controller) updateStatus(vmi *virtv1.VirtualMachineInstance, pod *k8sv1
 		conditionManager.RemoveCondition(vmiCopy, virtv1.VirtualMachineInstanceEvictionRequested)
 	}
 
+	syncSchedulingBlockedByQuotaCondition(conditionManager, vmiCopy, syncErr)
+
 	// VMI is owned by virt-handler, so patch instead of update
 	if vmi.IsRunning() || vmi.IsScheduled() {
 		patchSet := prepareVMIPatch(vmi, vmiCopy)
@@ -630,6 +637,52 @@ func (c *Controller) updateStatus(vmi *virtv1.VirtualMachineInstance, pod *k8sv1
 	return nil
 }
 
+// limitRangeViolationRegex matches the errors returned by the LimitRanger admission plugin
+var limitRangeViolationRegex = regexp.MustCompile(`(maximum|minimum) \S+ usage per (Pod|Container)|max limit to request ratio per (Pod|Container)`)
+
+// podCreationBlockedReason returns the VMI condition reason when the error message of a rejected
+// virt-launcher pod creation comes from a ResourceQuota or a LimitRange, or an empty string otherwise.
+func podCreationBlockedReason(errMsg string) string {
+	switch {
+	case strings.Contains(errMsg, "exceeded quota"):
+		return virtv1.VirtualMachineInstanceReasonResourceQuotaExceeded
+	case limitRangeViolationRegex.MatchString(errMsg):
+		return virtv1.VirtualMachineInstanceReasonLimitRangeViolated
+	default:
+		return ""
+	}
+}
+
+func syncSchedulingBlockedByQuotaCondition(
+	conditionManager *controller.VirtualMachineInstanceConditionManager,
+	vmi *virtv1.VirtualMachineInstance,
+	syncErr common.SyncError,
+) {
+	var blockedReason string
+	if syncErr != nil {
+		blockedReason = podCreationBlockedReason(syncErr.Error())
+	}
+
+	if blockedReason == "" {
+		conditionManager.RemoveCondition(vmi, virtv1.VirtualMachineInstanceSchedulingBlockedByQuota)
+		return
+	}
+
+	if conditionManager.HasConditionWithStatusAndReason(vmi, virtv1.VirtualMachineInstanceSchedulingBlockedByQuota, k8sv1.ConditionTrue, blockedReason) {
+		return
+	}
+
+	now := v1.Now()
+	conditionManager.UpdateCondition(vmi, &virtv1.VirtualMachineInstanceCondition{
+		Type:               virtv1.VirtualMachineInstanceSchedulingBlockedByQuota,
+		Status:             k8sv1.ConditionTrue,
+		Reason:             blockedReason,
+		Message:            syncErr.Error(),
+		LastProbeTime:      now,
+		LastTransitionTime: now,
+	})
+}
+
 func (c *Controller) addTopologyHints(vmi *virtv1.VirtualMachineInstance, vmiCopy *virtv1.VirtualMachineInstance) error {
 	if vmi.Status.TopologyHints == nil {
 		if topologyHints, tscRequirement, err := c.topologyHinter.TopologyHintsForVMI(vmi); err != nil && tscRequirement == topology.RequiredForBoot {
@@ -677,6 +730,18 @@ func prepareVMIPatch(oldVMI, newVMI *virtv1.VirtualMachineInstance) *patch.Patch
 		log.Log.V(3).Object(oldVMI).Infof("Patching VMI activePods")
 	}
 
+	if !equality.Semantic.DeepEqual(newVMI.Status.ProbeHistory, oldVMI.Status.ProbeHistory) {
+		if oldVMI.Status.ProbeHistory == nil {
+			patchSet.AddOption(patch.WithAdd("/status/probeHistory", newVMI.Status.ProbeHistory))
+		} else {
+			patchSet.AddOption(
+				patch.WithTest("/status/probeHistory", oldVMI.Status.ProbeHistory),
+				patch.WithReplace("/status/probeHistory", newVMI.Status.ProbeHistory),
+			)
+		}
+		log.Log.V(3).Object(oldVMI).Infof("Patching VMI probeHistory")
+	}
+
 	if newVMI.Status.Phase != oldVMI.Status.Phase {
 		patchSet.AddOption(
 			patch.WithTest("/status/phase", oldVMI.Status.Phase),
@@ -902,6 +967,9 @@ func (c *Controller) syncReadyConditionFromPod(vmi *virtv1.VirtualMachineInstanc
 			LastProbeTime:      podReadyCond.LastProbeTime,
 			LastTransitionTime: podReadyCond.LastTransitionTime,
 		})
+		if vmi.Spec.ReadinessProbe != nil {
+			appendProbeStateChange(vmi, podReadyCond)
+		}
 	} else {
 		vmiConditions.UpdateCondition(vmi, &virtv1.VirtualMachineInstanceCondition{
 			Type:               virtv1.VirtualMachineInstanceReady,
@@ -914,6 +982,24 @@ func (c *Controller) syncReadyConditionFromPod(vmi *virtv1.VirtualMachineInstanc
 	}
 }
 
+// appendProbeStateChange records a readiness probe status change of the vmi
+// in its probe history, keeping only the most recent maxProbeHistoryLength entries.
+func appendProbeStateChange(vmi *virtv1.VirtualMachineInstance, podReadyCond *k8sv1.PodCondition) {
+	history := vmi.Status.ProbeHistory
+	if len(history) > 0 && history[len(history)-1].Status == podReadyCond.Status {
+		return
+	}
+	history = append(history, virtv1.VirtualMachineInstanceProbeStateChange{
+		Status:              podReadyCond.Status,
+		Reason:              podReadyCond.Reason,
+		TransitionTimestamp: podReadyCond.LastTransitionTime,
+	})
+	if len(history) > maxProbeHistoryLength {
+		history = history[len(history)-maxProbeHistoryLength:]
+	}
+	vmi.Status.ProbeHistory = history
+}
+
 func (c *Controller) syncPausedConditionToPod(vmi *virtv1.VirtualMachineInstance, originalPod *k8sv1.Pod) error {
 	vmiConditions := controller.NewVirtualMachineInstanceConditionManager()
 	podConditions := controller.NewPodConditionManager()
@@ -35,6 +35,7 @@ import (
 	"kubevirt.io/client-go/log"
 
 	"kubevirt.io/kubevirt/pkg/controller"
+	virtcontrollermetrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-controller"
 	backendstorage "kubevirt.io/kubevirt/pkg/storage/backend-storage"
 	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/common"
@@ -272,7 +273,9 @@ func (c *Controller) updateVolumeStatus(vmi *virtv1.VirtualMachineInstance, virt
 		return err
 	}
 
-	attachmentPod, _ := getActiveAndOldAttachmentPods(hotplugVolumes, attachmentPods)
+	// The attachment pod is created without the held volumes, see handleHotplugVolumes
+	heldVolumes := c.ephemeralHotplugVolumesOverLimit(vmi, hotplugVolumes, attachmentPods)
+	attachmentPod, _ := getActiveAndOldAttachmentPods(withoutHeldVolumes(hotplugVolumes, heldVolumes), attachmentPods)
 
 	newStatus := make([]virtv1.VolumeStatus, 0)
 
@@ -308,9 +311,16 @@ func (c *Controller) updateVolumeStatus(vmi *virtv1.VirtualMachineInstance, virt
 			}
 		}
 		pvcName := storagetypes.PVCNameFromVirtVolume(&volume)
+		wasHeld := status.Reason == controller.EphemeralHotplugVolumeLimitReachedReason
+		_, held := heldVolumes[volume.Name]
 
 		if _, ok := hotplugVolumesMap[volume.Name]; ok {
-			c.processHotplugVolumeStatus(vmi, volume.Name, pvcName, &status, attachmentPod)
+			volumeAttachmentPod := attachmentPod
+			if held {
+				// Held volumes are not part of the attachment pod
+				volumeAttachmentPod = nil
+			}
+			c.processHotplugVolumeStatus(vmi, volume.Name, pvcName, &status, volumeAttachmentPod)
 		}
 		if volume.VolumeSource.PersistentVolumeClaim != nil || volume.VolumeSource.DataVolume != nil || volume.VolumeSource.MemoryDump != nil {
 			err = c.processPVCInfo(&status, pvcName, vmi.Namespace, false)
@@ -318,6 +328,9 @@ func (c *Controller) updateVolumeStatus(vmi *virtv1.VirtualMachineInstance, virt
 				return err
 			}
 		}
+		if held {
+			c.setEphemeralHotplugVolumeHeld(vmi, &status, wasHeld)
+		}
 
 		newStatus = append(newStatus, status)
 	}
@@ -367,21 +380,27 @@ func (c *Controller) updateVolumeStatus(vmi *virtv1.VirtualMachineInstance, virt
 	return nil
 }
 
-func (c *Controller) checkEphemeralHotplugVolumes(vmi *virtv1.VirtualMachineInstance) {
-	vm := c.getOwnerVM(vmi)
-	if vmi == nil || vm == nil {
+// setEphemeralHotplugVolumeHeld reports in the volume status that the volume is held because the
+// ephemeral hotplug volume limit is reached. The event and the metric are only recorded when the
+// volume enters the held state, not on every reconcile while it stays held.
+func (c *Controller) setEphemeralHotplugVolumeHeld(vmi *virtv1.VirtualMachineInstance, status *virtv1.VolumeStatus, wasHeld bool) {
+	status.Reason = controller.EphemeralHotplugVolumeLimitReachedReason
+	status.Message = fmt.Sprintf("Ephemeral hotplug volume %s is held, the limit of ephemeral hotplug volumes is reached", status.Name)
+	if wasHeld {
 		return
 	}
+	c.recorder.Eventf(vmi, k8sv1.EventTypeWarning, status.Reason, status.Message)
+	virtcontrollermetrics.EphemeralHotplugVolumeHeld(vmi.Namespace)
+}
 
+// ephemeralHotplugVolumeNames returns the names of the hotplug volumes of the VMI that are not
+// part of the VM spec.
+func ephemeralHotplugVolumeNames(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) []string {
 	vmVolumeMap := map[string]struct{}{}
 	for _, volume := range vm.Spec.Template.Spec.Volumes {
 		vmVolumeMap[volume.Name] = struct{}{}
 	}
 
-	annotations := vmi.Annotations
-	if annotations == nil {
-		annotations = make(map[string]string)
-	}
 	var ephemeralVols []string
 	// check if the vmi has any volumes that are not in the vm spec
 	for _, volume := range vmi.Spec.Volumes {
@@ -392,6 +411,20 @@ func (c *Controller) checkEphemeralHotplugVolumes(vmi *virtv1.VirtualMachineInst
 			ephemeralVols = append(ephemeralVols, volume.Name)
 		}
 	}
+	return ephemeralVols
+}
+
+func (c *Controller) checkEphemeralHotplugVolumes(vmi *virtv1.VirtualMachineInstance) {
+	vm := c.getOwnerVM(vmi)
+	if vmi == nil || vm == nil {
+		return
+	}
+
+	annotations := vmi.Annotations
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	ephemeralVols := ephemeralHotplugVolumeNames(vm, vmi)
 
 	if len(ephemeralVols) == 0 {
 		// no ephemeral hotplugs were found, remove label if it exists
@@ -51,6 +51,7 @@ import (
 const (
 	deleteNotifFailed        = "Failed to process delete notification"
 	tombstoneGetObjectErrFmt = "couldn't get object from tombstone %+v"
+	maxProbeHistoryLength    = 10
 )
 
 func NewController(templateService templateService,
@@ -67,6 +68,7 @@ func NewController(templateService templateService,
 	cdiInformer cache.SharedIndexInformer,
 	cdiConfigInformer cache.SharedIndexInformer,
 	kubeVirtInformer cache.SharedIndexInformer,
+	namespaceInformer cache.SharedIndexInformer,
 	clusterConfig *virtconfig.ClusterConfig,
 	topologyHinter topology.Hinter,
 	netAnnotationsGenerator annotationsGenerator,
@@ -97,6 +99,7 @@ func NewController(templateService templateService,
 		dataVolumeIndexer:                 dataVolumeInformer.GetIndexer(),
 		cdiStore:                          cdiInformer.GetStore(),
 		cdiConfigStore:                    cdiConfigInformer.GetStore(),
+		namespaceStore:                    namespaceInformer.GetStore(),
 		clusterConfig:                     clusterConfig,
 		topologyHinter:                    topologyHinter,
 		cidsMap:                           vsock.NewCIDsMap(),
@@ -114,7 +117,7 @@ func NewController(templateService templateService,
 		return vmInformer.HasSynced() && vmiInformer.HasSynced() && podInformer.HasSynced() &&
 			dataVolumeInformer.HasSynced() && cdiConfigInformer.HasSynced() && cdiInformer.HasSynced() &&
 			pvcInformer.HasSynced() && storageClassInformer.HasSynced() && storageProfileInformer.HasSynced() &&
-			kubeVirtInformer.HasSynced()
+			kubeVirtInformer.HasSynced() && namespaceInformer.HasSynced()
 	}
 
 	_, err := vmiInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
@@ -236,6 +239,7 @@ type Controller struct {
 	dataVolumeIndexer                 cache.Indexer
 	cdiStore                          cache.Store
 	cdiConfigStore                    cache.Store
+	namespaceStore                    cache.Store
 	clusterConfig                     *virtconfig.ClusterConfig
 	cidsMap                           vsock.Allocator
 	backendStorage                    *backendstorage.BackendStorage
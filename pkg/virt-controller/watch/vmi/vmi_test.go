@@ -21,6 +21,7 @@ package vmi
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"slices"
 	"strconv"
@@ -245,6 +246,7 @@ var _ = Describe("VirtualMachineInstance watcher", func() {
 			cdiInformer,
 			cdiConfigInformer,
 			kubeVirtInformer,
+			nsInformer,
 			config,
 			topology.NewTopologyHinter(&cache.FakeCustomStore{}, &cache.FakeCustomStore{}, config),
 			stubNetworkAnnotationsGenerator{},
@@ -1194,6 +1196,53 @@ var _ = Describe("VirtualMachineInstance watcher", func() {
 				})),
 			)
 		})
+		DescribeTable("should set the SchedulingBlockedByQuota condition if creating the pod is rejected", func(errMsg, expectedReason string) {
+			vmi := newPendingVirtualMachine("testvmi")
+
+			addVirtualMachine(vmi)
+
+			kubeClient.Fake.PrependReactor("create", "pods", func(action testing.Action) (handled bool, obj k8sruntime.Object, err error) {
+				return true, nil, k8serrors.NewForbidden(k8sv1.Resource("pods"), "virt-launcher-testvmi", errors.New(errMsg))
+			})
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, kvcontroller.FailedCreatePodReason)
+			expectVMIWithMatcherConditions(vmi.Namespace, vmi.Name, ContainElement(MatchFields(IgnoreExtras,
+				Fields{
+					"Type":   Equal(virtv1.VirtualMachineInstanceSchedulingBlockedByQuota),
+					"Status": Equal(k8sv1.ConditionTrue),
+					"Reason": Equal(expectedReason),
+				})),
+			)
+		},
+			Entry("by a ResourceQuota",
+				"exceeded quota: compute, requested: requests.memory=2Gi, used: requests.memory=1Gi, limited: requests.memory=2Gi",
+				virtv1.VirtualMachineInstanceReasonResourceQuotaExceeded,
+			),
+			Entry("by a LimitRange",
+				"maximum memory usage per Container is 1Gi, but limit is 2Gi",
+				virtv1.VirtualMachineInstanceReasonLimitRangeViolated,
+			),
+		)
+		It("should not set the SchedulingBlockedByQuota condition if creating the pod fails for another reason", func() {
+			vmi := newPendingVirtualMachine("testvmi")
+
+			addVirtualMachine(vmi)
+
+			kubeClient.Fake.PrependReactor("create", "pods", func(action testing.Action) (handled bool, obj k8sruntime.Object, err error) {
+				return true, nil, fmt.Errorf("random error")
+			})
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, kvcontroller.FailedCreatePodReason)
+			expectVMIWithMatcherConditions(vmi.Namespace, vmi.Name, Not(ContainElement(MatchFields(IgnoreExtras,
+				Fields{
+					"Type": Equal(virtv1.VirtualMachineInstanceSchedulingBlockedByQuota),
+				}))),
+			)
+		})
 		It("should back-off if a sync error occurs", func() {
 			vmi := newPendingVirtualMachine("testvmi")
 
@@ -1944,6 +1993,90 @@ var _ = Describe("VirtualMachineInstance watcher", func() {
 				))
 		})
 
+		Context("with a readiness probe", func() {
+			runWithPodReady := func(vmi *virtv1.VirtualMachineInstance, status k8sv1.ConditionStatus) *virtv1.VirtualMachineInstance {
+				vmi.Spec.ReadinessProbe = &virtv1.Probe{}
+				vmi.Status.Phase = virtv1.Running
+				pod := newPodForVirtualMachine(vmi, k8sv1.PodRunning)
+				pod.Status.Conditions = append(pod.Status.Conditions, k8sv1.PodCondition{
+					Type:   k8sv1.PodReady,
+					Status: status,
+					Reason: "ProbeReason",
+				})
+
+				addVirtualMachine(vmi)
+				addActivePods(vmi, pod.UID, "")
+				addPod(pod)
+
+				sanityExecute()
+				updatedVmi, err := virtClientset.KubevirtV1().VirtualMachineInstances(vmi.Namespace).Get(context.Background(), vmi.Name, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				return updatedVmi
+			}
+
+			It("should record a probe status change in the probe history", func() {
+				vmi := newPendingVirtualMachine("testvmi")
+				vmi.Status.Conditions = nil
+				vmi.Status.ProbeHistory = []virtv1.VirtualMachineInstanceProbeStateChange{
+					{Status: k8sv1.ConditionTrue},
+				}
+
+				updatedVmi := runWithPodReady(vmi, k8sv1.ConditionFalse)
+				Expect(updatedVmi.Status.ProbeHistory).To(HaveLen(2))
+				Expect(updatedVmi.Status.ProbeHistory[1].Status).To(Equal(k8sv1.ConditionFalse))
+				Expect(updatedVmi.Status.ProbeHistory[1].Reason).To(Equal("ProbeReason"))
+			})
+
+			It("should not record an unchanged probe status in the probe history", func() {
+				vmi := newPendingVirtualMachine("testvmi")
+				vmi.Status.Conditions = nil
+				vmi.Status.ProbeHistory = []virtv1.VirtualMachineInstanceProbeStateChange{
+					{Status: k8sv1.ConditionTrue},
+				}
+
+				updatedVmi := runWithPodReady(vmi, k8sv1.ConditionTrue)
+				Expect(updatedVmi.Status.ProbeHistory).To(HaveLen(1))
+			})
+
+			It("should keep only the most recent probe status changes in the probe history", func() {
+				vmi := newPendingVirtualMachine("testvmi")
+				vmi.Status.Conditions = nil
+				for i := 0; i < maxProbeHistoryLength; i++ {
+					status := k8sv1.ConditionTrue
+					if i%2 != 0 {
+						status = k8sv1.ConditionFalse
+					}
+					vmi.Status.ProbeHistory = append(vmi.Status.ProbeHistory, virtv1.VirtualMachineInstanceProbeStateChange{
+						Status: status,
+						Reason: fmt.Sprintf("change-%d", i),
+					})
+				}
+
+				updatedVmi := runWithPodReady(vmi, k8sv1.ConditionTrue)
+				Expect(updatedVmi.Status.ProbeHistory).To(HaveLen(maxProbeHistoryLength))
+				Expect(updatedVmi.Status.ProbeHistory[0].Reason).To(Equal("change-1"))
+				Expect(updatedVmi.Status.ProbeHistory[maxProbeHistoryLength-1].Status).To(Equal(k8sv1.ConditionTrue))
+				Expect(updatedVmi.Status.ProbeHistory[maxProbeHistoryLength-1].Reason).To(Equal("ProbeReason"))
+			})
+
+			It("should not record the probe history without a readiness probe", func() {
+				vmi := newPendingVirtualMachine("testvmi")
+				vmi.Status.Conditions = nil
+				vmi.Status.Phase = virtv1.Running
+				pod := newPodForVirtualMachine(vmi, k8sv1.PodRunning)
+				pod.Status.Conditions = append(pod.Status.Conditions, k8sv1.PodCondition{Type: k8sv1.PodReady, Status: k8sv1.ConditionTrue})
+
+				addVirtualMachine(vmi)
+				addActivePods(vmi, pod.UID, "")
+				addPod(pod)
+
+				sanityExecute()
+				updatedVmi, err := virtClientset.KubevirtV1().VirtualMachineInstances(vmi.Namespace).Get(context.Background(), vmi.Name, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(updatedVmi.Status.ProbeHistory).To(BeEmpty())
+			})
+		})
+
 		It("should indicate on the ready condition if the pod is terminating", func() {
 			vmi := newPendingVirtualMachine("testvmi")
 			vmi.Status.Conditions = nil
@@ -3216,6 +3349,136 @@ var _ = Describe("VirtualMachineInstance watcher", func() {
 				nil),
 		)
 
+		DescribeTable("should hold ephemeral hotplug volumes over the limit", func(clusterLimit *uint32, namespaceAnnotations map[string]string, vmVolumeIndexes []int, attachedIndexes []int, expectedVolumes []string) {
+			if clusterLimit != nil {
+				kvCR := testutils.GetFakeKubeVirtClusterConfig(kvStore)
+				kvCR.Spec.Configuration.MaxEphemeralHotplugVolumes = clusterLimit
+				testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kvCR)
+			}
+			Expect(controller.namespaceStore.Add(&k8sv1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        k8sv1.NamespaceDefault,
+					Annotations: namespaceAnnotations,
+				},
+			})).To(Succeed())
+
+			vmi := newPendingVirtualMachine("testvmi")
+			vm := watchtesting.VirtualMachineFromVMI(vmi.Name, vmi, true)
+			vm.UID = "123"
+			vm.Spec.Template.Spec.Volumes = nil
+			for _, volume := range makeVolumes(vmVolumeIndexes...) {
+				vm.Spec.Template.Spec.Volumes = append(vm.Spec.Template.Spec.Volumes, *volume)
+			}
+			Expect(controller.vmStore.Add(vm)).To(Succeed())
+			vmi.OwnerReferences = []metav1.OwnerReference{*metav1.NewControllerRef(vm, virtv1.VirtualMachineGroupVersionKind)}
+
+			hotplugVolumes := makeVolumes(1, 2, 3)
+			for _, volume := range hotplugVolumes {
+				volume.PersistentVolumeClaim.Hotpluggable = true
+				vmi.Spec.Volumes = append(vmi.Spec.Volumes, *volume)
+			}
+
+			volumes := controller.holdEphemeralHotplugVolumesOverLimit(vmi, hotplugVolumes, makePods(attachedIndexes...))
+			var volumeNames []string
+			for _, volume := range volumes {
+				volumeNames = append(volumeNames, volume.Name)
+			}
+			Expect(volumeNames).To(Equal(expectedVolumes))
+		},
+			Entry("should keep all volumes without a limit",
+				nil, nil, nil, nil, []string{"volume1", "volume2", "volume3"}),
+			Entry("should keep all volumes when the limit is not reached",
+				pointer.P(uint32(3)), nil, nil, nil, []string{"volume1", "volume2", "volume3"}),
+			Entry("should hold volumes over the cluster limit",
+				pointer.P(uint32(1)), nil, nil, nil, []string{"volume1"}),
+			Entry("should not count volumes which are part of the VM",
+				pointer.P(uint32(1)), nil, []int{1}, nil, []string{"volume1", "volume2"}),
+			Entry("should keep already attached volumes and count them towards the limit",
+				pointer.P(uint32(1)), nil, nil, []int{3}, []string{"volume3"}),
+			Entry("should lower the cluster limit with the namespace annotation",
+				pointer.P(uint32(2)), map[string]string{virtv1.MaxEphemeralHotplugVolumesAnnotation: "1"}, nil, nil, []string{"volume1"}),
+			Entry("should not raise the cluster limit with the namespace annotation",
+				pointer.P(uint32(1)), map[string]string{virtv1.MaxEphemeralHotplugVolumesAnnotation: "2"}, nil, nil, []string{"volume1"}),
+			Entry("should apply the namespace annotation without a cluster limit",
+				nil, map[string]string{virtv1.MaxEphemeralHotplugVolumesAnnotation: "0"}, nil, nil, nil),
+			Entry("should ignore an invalid namespace annotation",
+				pointer.P(uint32(1)), map[string]string{virtv1.MaxEphemeralHotplugVolumesAnnotation: "-1"}, nil, nil, []string{"volume1"}),
+		)
+
+		It("should report held ephemeral hotplug volumes in the volume status and record the event once", func() {
+			kvCR := testutils.GetFakeKubeVirtClusterConfig(kvStore)
+			kvCR.Spec.Configuration.MaxEphemeralHotplugVolumes = pointer.P(uint32(1))
+			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kvCR)
+
+			vmi := newPendingVirtualMachine("testvmi")
+			vm := watchtesting.VirtualMachineFromVMI(vmi.Name, vmi, true)
+			vm.UID = "123"
+			vm.Spec.Template.Spec.Volumes = nil
+			Expect(controller.vmStore.Add(vm)).To(Succeed())
+			vmi.OwnerReferences = []metav1.OwnerReference{*metav1.NewControllerRef(vm, virtv1.VirtualMachineGroupVersionKind)}
+			for _, volume := range makeVolumes(1, 2) {
+				volume.PersistentVolumeClaim.Hotpluggable = true
+				vmi.Spec.Volumes = append(vmi.Spec.Volumes, *volume)
+			}
+			preparePVC(1, 2)
+			virtlauncherPod := newPodForVirtualMachine(vmi, k8sv1.PodRunning)
+
+			Expect(controller.updateVolumeStatus(vmi, virtlauncherPod)).To(Succeed())
+			testutils.ExpectEvent(recorder, kvcontroller.EphemeralHotplugVolumeLimitReachedReason)
+			Expect(vmi.Status.VolumeStatus).To(HaveLen(2))
+			Expect(vmi.Status.VolumeStatus[0].Reason).ToNot(Equal(kvcontroller.EphemeralHotplugVolumeLimitReachedReason))
+			Expect(vmi.Status.VolumeStatus[1].Name).To(Equal("volume2"))
+			Expect(vmi.Status.VolumeStatus[1].Reason).To(Equal(kvcontroller.EphemeralHotplugVolumeLimitReachedReason))
+
+			// The volume stays held, the event must not be recorded again
+			Expect(controller.updateVolumeStatus(vmi, virtlauncherPod)).To(Succeed())
+			Expect(vmi.Status.VolumeStatus[1].Reason).To(Equal(kvcontroller.EphemeralHotplugVolumeLimitReachedReason))
+		})
+
+		It("should keep the attachment pod of the volumes that are not held", func() {
+			kvCR := testutils.GetFakeKubeVirtClusterConfig(kvStore)
+			kvCR.Spec.Configuration.MaxEphemeralHotplugVolumes = pointer.P(uint32(2))
+			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kvCR)
+
+			vmi := newPendingVirtualMachine("testvmi")
+			vm := watchtesting.VirtualMachineFromVMI(vmi.Name, vmi, true)
+			vm.UID = "123"
+			vm.Spec.Template.Spec.Volumes = nil
+			Expect(controller.vmStore.Add(vm)).To(Succeed())
+			vmi.OwnerReferences = []metav1.OwnerReference{*metav1.NewControllerRef(vm, virtv1.VirtualMachineGroupVersionKind)}
+			for _, volume := range makeVolumes(1, 2, 3) {
+				volume.PersistentVolumeClaim.Hotpluggable = true
+				vmi.Spec.Volumes = append(vmi.Spec.Volumes, *volume)
+			}
+			preparePVC(1, 2, 3)
+			virtlauncherPod := newPodForVirtualMachine(vmi, k8sv1.PodRunning)
+			attachmentPod := makePodWithVirtlauncher(virtlauncherPod, 1, 2)[0]
+			Expect(controller.podIndexer.Add(attachmentPod)).To(Succeed())
+			for _, name := range []string{"volume1", "volume2"} {
+				addVolumeStatuses(vmi, virtv1.VolumeStatus{
+					Name:  name,
+					Phase: virtv1.HotplugVolumeAttachedToNode,
+					HotplugVolume: &virtv1.HotplugVolumeStatus{
+						AttachPodName: attachmentPod.Name,
+						AttachPodUID:  attachmentPod.UID,
+					},
+				})
+			}
+
+			for range 2 {
+				Expect(controller.updateVolumeStatus(vmi, virtlauncherPod)).To(Succeed())
+				Expect(vmi.Status.VolumeStatus).To(HaveLen(3))
+				for _, status := range vmi.Status.VolumeStatus[:2] {
+					Expect(status.Phase).To(Equal(virtv1.HotplugVolumeAttachedToNode))
+					Expect(status.HotplugVolume.AttachPodUID).To(Equal(attachmentPod.UID))
+				}
+				Expect(vmi.Status.VolumeStatus[2].Name).To(Equal("volume3"))
+				Expect(vmi.Status.VolumeStatus[2].Reason).To(Equal(kvcontroller.EphemeralHotplugVolumeLimitReachedReason))
+			}
+			// The held event is recorded once across both syncs, the AfterEach checks no other event is left
+			testutils.ExpectEvent(recorder, kvcontroller.EphemeralHotplugVolumeLimitReachedReason)
+		})
+
 		DescribeTable("needsHandleHotplug", func(hotplugVolumes []*virtv1.Volume, hotplugAttachmentPods []*k8sv1.Pod, expected bool) {
 			res := needsHandleHotplug(hotplugVolumes, hotplugAttachmentPods)
 			Expect(res).To(Equal(expected))
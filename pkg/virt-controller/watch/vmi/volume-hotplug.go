@@ -23,6 +23,7 @@ import (
 	"errors"
 	"fmt"
 	"sort"
+	"strconv"
 	"time"
 
 	k8sv1 "k8s.io/api/core/v1"
@@ -33,6 +34,7 @@ import (
 	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 
 	"kubevirt.io/kubevirt/pkg/controller"
+	virtcontrollermetrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-controller"
 	"kubevirt.io/kubevirt/pkg/pointer"
 	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/common"
@@ -156,6 +158,8 @@ func (c *Controller) isUtilityVolumeWithBlockPVC(vmi *v1.VirtualMachineInstance,
 func (c *Controller) handleHotplugVolumes(hotplugVolumes []*v1.Volume, hotplugAttachmentPods []*k8sv1.Pod, vmi *v1.VirtualMachineInstance, virtLauncherPod *k8sv1.Pod, dataVolumes []*cdiv1.DataVolume) common.SyncError {
 	logger := log.Log.Object(vmi)
 
+	hotplugVolumes = c.holdEphemeralHotplugVolumesOverLimit(vmi, hotplugVolumes, hotplugAttachmentPods)
+
 	readyHotplugVolumes := make([]*v1.Volume, 0)
 	// Find all ready volumes
 	for _, volume := range hotplugVolumes {
@@ -213,6 +217,111 @@ func (c *Controller) handleHotplugVolumes(hotplugVolumes []*v1.Volume, hotplugAt
 	return nil
 }
 
+// holdEphemeralHotplugVolumesOverLimit removes from hotplugVolumes the ephemeral hotplug volumes
+// that would exceed the maximum allowed for the VMI. Held volumes are attached once other ones are
+// removed from the VMI. The volume status reports them, see updateVolumeStatus.
+func (c *Controller) holdEphemeralHotplugVolumesOverLimit(vmi *v1.VirtualMachineInstance, hotplugVolumes []*v1.Volume, hotplugAttachmentPods []*k8sv1.Pod) []*v1.Volume {
+	heldVolumes := c.ephemeralHotplugVolumesOverLimit(vmi, hotplugVolumes, hotplugAttachmentPods)
+	if len(heldVolumes) == 0 {
+		return hotplugVolumes
+	}
+	log.Log.Object(vmi).V(3).Infof("Holding ephemeral hotplug volumes %v, limit reached", heldVolumes)
+	return withoutHeldVolumes(hotplugVolumes, heldVolumes)
+}
+
+func withoutHeldVolumes(hotplugVolumes []*v1.Volume, heldVolumes map[string]struct{}) []*v1.Volume {
+	if len(heldVolumes) == 0 {
+		return hotplugVolumes
+	}
+	volumes := make([]*v1.Volume, 0, len(hotplugVolumes))
+	for _, volume := range hotplugVolumes {
+		if _, held := heldVolumes[volume.Name]; !held {
+			volumes = append(volumes, volume)
+		}
+	}
+	return volumes
+}
+
+// ephemeralHotplugVolumesOverLimit returns the names of the ephemeral hotplug volumes that exceed
+// the maximum allowed for the VMI. Volumes which are already part of an attachment pod are never
+// held, so lowering the limit does not detach anything.
+func (c *Controller) ephemeralHotplugVolumesOverLimit(vmi *v1.VirtualMachineInstance, hotplugVolumes []*v1.Volume, hotplugAttachmentPods []*k8sv1.Pod) map[string]struct{} {
+	maxVolumes := c.getMaxEphemeralHotplugVolumes(vmi.Namespace)
+	if maxVolumes == nil {
+		return nil
+	}
+	vm := c.getOwnerVM(vmi)
+	if vm == nil {
+		return nil
+	}
+
+	ephemeralVolumes := map[string]struct{}{}
+	for _, name := range ephemeralHotplugVolumeNames(vm, vmi) {
+		ephemeralVolumes[name] = struct{}{}
+	}
+	if len(ephemeralVolumes) <= int(*maxVolumes) {
+		return nil
+	}
+
+	attachedVolumes := map[string]struct{}{}
+	for _, attachmentPod := range hotplugAttachmentPods {
+		for _, podVolume := range attachmentPod.Spec.Volumes {
+			attachedVolumes[podVolume.Name] = struct{}{}
+		}
+	}
+	allowed := 0
+	for name := range ephemeralVolumes {
+		if _, attached := attachedVolumes[name]; attached {
+			allowed++
+		}
+	}
+
+	heldVolumes := map[string]struct{}{}
+	for _, volume := range hotplugVolumes {
+		_, ephemeral := ephemeralVolumes[volume.Name]
+		_, attached := attachedVolumes[volume.Name]
+		if ephemeral && !attached {
+			if allowed >= int(*maxVolumes) {
+				heldVolumes[volume.Name] = struct{}{}
+				continue
+			}
+			allowed++
+		}
+	}
+	return heldVolumes
+}
+
+// getMaxEphemeralHotplugVolumes returns the maximum number of ephemeral hotplug volumes allowed for
+// VMIs in the namespace. The namespace annotation can only lower the cluster wide limit. nil means
+// there is no limit.
+func (c *Controller) getMaxEphemeralHotplugVolumes(namespace string) *uint32 {
+	clusterLimit := c.clusterConfig.GetMaxEphemeralHotplugVolumes()
+
+	obj, exists, err := c.namespaceStore.GetByKey(namespace)
+	if err != nil || !exists {
+		return clusterLimit
+	}
+	ns, ok := obj.(*k8sv1.Namespace)
+	if !ok {
+		return clusterLimit
+	}
+
+	value, ok := ns.GetAnnotations()[v1.MaxEphemeralHotplugVolumesAnnotation]
+	if !ok {
+		return clusterLimit
+	}
+	limit, err := strconv.ParseUint(value, 10, 32)
+	if err != nil {
+		log.Log.Warningf("%s is an invalid value for %s annotation in namespace %s, using the cluster wide limit", value, v1.MaxEphemeralHotplugVolumesAnnotation, namespace)
+		return clusterLimit
+	}
+	if clusterLimit != nil && uint32(limit) > *clusterLimit {
+		log.Log.V(3).Infof("%s annotation in namespace %s exceeds the cluster wide limit of %d, using the cluster wide limit", v1.MaxEphemeralHotplugVolumesAnnotation, namespace, *clusterLimit)
+		return clusterLimit
+	}
+	return pointer.P(uint32(limit))
+}
+
 func (c *Controller) createAttachmentPod(vmi *v1.VirtualMachineInstance, virtLauncherPod *k8sv1.Pod, volumes []*v1.Volume) (*k8sv1.Pod, common.SyncError) {
 	attachmentPodTemplate, _ := c.createAttachmentPodTemplate(vmi, virtLauncherPod, volumes)
 	if attachmentPodTemplate == nil {
@@ -222,6 +331,7 @@ func (c *Controller) createAttachmentPod(vmi *v1.VirtualMachineInstance, virtLau
 	pod, err := c.createPod(vmiKey, vmi.Namespace, attachmentPodTemplate)
 	if err != nil {
 		c.recorder.Eventf(vmi, k8sv1.EventTypeWarning, controller.FailedCreatePodReason, "Error creating attachment pod: %v", err)
+		virtcontrollermetrics.HotplugVolumeAttachFailed(controller.FailedCreatePodReason)
 		return nil, common.NewSyncError(fmt.Errorf("Error creating attachment pod %v", err), controller.FailedCreatePodReason)
 	}
 	c.recorder.Eventf(vmi, k8sv1.EventTypeNormal, controller.SuccessfulCreatePodReason, "Created attachment pod %s", pod.Name)
@@ -238,6 +348,7 @@ func (c *Controller) triggerHotplugPopulation(volume *v1.Volume, vmi *v1.Virtual
 		_, err = c.createPod(vmiKey, vmi.Namespace, populateHotplugPodTemplate)
 		if err != nil {
 			c.recorder.Eventf(vmi, k8sv1.EventTypeWarning, controller.FailedCreatePodReason, "Error creating hotplug population trigger pod for volume %s: %v", volume.Name, err)
+			virtcontrollermetrics.HotplugVolumeAttachFailed(controller.FailedCreatePodReason)
 			return common.NewSyncError(fmt.Errorf("Error creating hotplug population trigger pod %v", err), controller.FailedCreatePodReason)
 		}
 		c.recorder.Eventf(vmi, k8sv1.EventTypeNormal, controller.SuccessfulCreatePodReason, "Created hotplug trigger pod for volume %s", volume.Name)
@@ -384,7 +495,8 @@ func (c *Controller) deleteAttachmentPod(vmi *v1.VirtualMachineInstance, attachm
 		GracePeriodSeconds: pointer.P(int64(0)),
 	})
 	if err != nil {
-		c.recorder.Eventf(vmi, k8sv1.EventTypeWarning, controller.FailedDeletePodReason, "Failed to delete attachment pod %s", attachmentPod.Name)
+		c.recorder.Eventf(vmi, k8sv1.EventTypeWarning, controller.FailedDeletePodReason, "Failed to delete attachment pod %s: %v", attachmentPod.Name, err)
+		virtcontrollermetrics.HotplugVolumeDetachFailed(controller.FailedDeletePodReason)
 		return err
 	}
 	c.recorder.Eventf(vmi, k8sv1.EventTypeNormal, controller.SuccessfulDeletePodReason, "Deleted attachment pod %s", attachmentPod.Name)
@@ -27,6 +27,7 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"time"
 
 	"kubevirt.io/client-go/log"
 
@@ -155,6 +156,51 @@ func NewManagerFromVM(vmi *v1.VirtualMachineInstance, host string, hypervisorDev
 	return newManagerFromPid(isolationRes.Pid(), vmiDeviceRules)
 }
 
+// GetCPUThrottledTime returns the total time the VMI's launcher cgroup was throttled
+// because it exhausted its CPU quota.
+func GetCPUThrottledTime(vmi *v1.VirtualMachineInstance) (time.Duration, error) {
+	isolationRes, err := detectVMIsolation(vmi)
+	if err != nil {
+		return 0, err
+	}
+
+	procCgroupBasePath := filepath.Join(cgroupconsts.ProcMountPoint, strconv.Itoa(isolationRes.Pid()), cgroupconsts.CgroupStr)
+	controllerPaths, err := runc_cgroups.ParseCgroupFile(procCgroupBasePath)
+	if err != nil {
+		return 0, fmt.Errorf("cannot read cgroup of vm \"%s\", err: %v", vmi.Name, err)
+	}
+
+	if runc_cgroups.IsCgroup2UnifiedMode() {
+		cpuStatPath := filepath.Join(cgroupconsts.CgroupBasePath, managerPath(controllerPaths[""]), "cpu.stat")
+		return readCPUStatThrottledTime(cpuStatPath, "throttled_usec", time.Microsecond)
+	}
+
+	cpuStatPath := filepath.Join(cgroupconsts.HostCgroupBasePath, "cpu", managerPath(controllerPaths["cpu"]), "cpu.stat")
+	return readCPUStatThrottledTime(cpuStatPath, "throttled_time", time.Nanosecond)
+}
+
+func readCPUStatThrottledTime(cpuStatPath, key string, unit time.Duration) (time.Duration, error) {
+	f, err := os.Open(cpuStatPath)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+	// File has lines in the format: "key value"
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		parts := strings.Fields(scanner.Text())
+		if len(parts) != 2 || parts[0] != key {
+			continue
+		}
+		value, err := strconv.ParseInt(parts[1], 10, 64)
+		if err != nil {
+			return 0, err
+		}
+		return time.Duration(value) * unit, nil
+	}
+	return 0, fmt.Errorf("key %s not found in %s", key, cpuStatPath)
+}
+
 // GetGlobalCpuSetPath returns the CPU set of the main cgroup slice
 func GetGlobalCpuSetPath() string {
 	if runc_cgroups.IsCgroup2UnifiedMode() {
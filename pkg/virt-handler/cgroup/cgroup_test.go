@@ -23,6 +23,7 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -248,6 +249,47 @@ var _ = Describe("GetMiscCapacity", func() {
 	)
 })
 
+var _ = Describe("readCPUStatThrottledTime", func() {
+	var cpuStatPath string
+
+	BeforeEach(func() {
+		cpuStatPath = path.Join(GinkgoT().TempDir(), "cpu.stat")
+	})
+
+	DescribeTable("should return correct throttled time",
+		func(fileContent string, key string, unit time.Duration, expectedTime time.Duration, expectError bool) {
+			if fileContent != "" {
+				err := os.WriteFile(cpuStatPath, []byte(fileContent), 0644)
+				Expect(err).ToNot(HaveOccurred())
+			}
+			throttledTime, err := readCPUStatThrottledTime(cpuStatPath, key, unit)
+			Expect(throttledTime).To(Equal(expectedTime))
+			if expectError {
+				Expect(err).To(HaveOccurred())
+			} else {
+				Expect(err).ToNot(HaveOccurred())
+			}
+		},
+		Entry("returns throttled time from a cgroup v2 cpu.stat",
+			"usage_usec 1000\nnr_periods 10\nnr_throttled 2\nthrottled_usec 1500\n",
+			"throttled_usec", time.Microsecond, 1500*time.Microsecond, false,
+		),
+		Entry("returns throttled time from a cgroup v1 cpu.stat",
+			"nr_periods 10\nnr_throttled 2\nthrottled_time 2500000\n",
+			"throttled_time", time.Nanosecond, 2500*time.Microsecond, false,
+		),
+		Entry("produces error when key not found",
+			"usage_usec 1000\n", "throttled_usec", time.Microsecond, time.Duration(0), true,
+		),
+		Entry("produces error for non-numeric value",
+			"throttled_usec abc\n", "throttled_usec", time.Microsecond, time.Duration(0), true,
+		),
+		Entry("produces error when the file does not exist",
+			"", "throttled_usec", time.Microsecond, time.Duration(0), true,
+		),
+	)
+})
+
 var _ = Describe("generateDeviceRulesForVMI", func() {
 	var (
 		ctrl    *gomock.Controller
@@ -977,20 +977,32 @@ func (c *MigrationTargetController) hotplugCPU(vmi *v1.VirtualMachineInstance, c
 		return nil
 	}
 
+	// The VCPUChange condition is removed in any case, the rolled back condition tells why the change was not applied
+	rollBack := func(reason string, err error) error {
+		vmiConditions.UpdateCondition(vmi, &v1.VirtualMachineInstanceCondition{
+			Type:               v1.VirtualMachineInstanceHotplugRolledBack,
+			Status:             k8sv1.ConditionTrue,
+			Reason:             reason,
+			Message:            err.Error(),
+			LastTransitionTime: metav1.Now(),
+		})
+		return err
+	}
+
 	if vmi.IsCPUDedicated() {
 		cpuLimitStr, ok := vmi.Labels[v1.VirtualMachinePodCPULimitsLabel]
 		if !ok || len(cpuLimitStr) == 0 {
-			return fmt.Errorf("cannot read CPU limit from VMI annotation")
+			return rollBack(v1.VirtualMachineInstanceReasonHotplugMigrationRequired, fmt.Errorf("cannot read CPU limit from VMI annotation"))
 		}
 
 		cpuLimit, err := strconv.Atoi(cpuLimitStr)
 		if err != nil {
-			return fmt.Errorf("cannot parse CPU limit from VMI annotation: %v", err)
+			return rollBack(v1.VirtualMachineInstanceReasonHotplugMigrationRequired, fmt.Errorf("cannot parse CPU limit from VMI annotation: %v", err))
 		}
 
 		vcpus := hardware.GetNumberOfVCPUs(vmi.Spec.Domain.CPU)
 		if vcpus > int64(cpuLimit) {
-			return fmt.Errorf("number of requested VCPUS (%d) exceeds the limit (%d)", vcpus, cpuLimit)
+			return rollBack(v1.VirtualMachineInstanceReasonHotplugMigrationRequired, fmt.Errorf("number of requested VCPUS (%d) exceeds the limit (%d)", vcpus, cpuLimit))
 		}
 	}
 
@@ -1002,8 +1014,9 @@ func (c *MigrationTargetController) hotplugCPU(vmi *v1.VirtualMachineInstance, c
 		c.clusterConfig)
 
 	if err := client.SyncVirtualMachineCPUs(vmi, options); err != nil {
-		return err
+		return rollBack(v1.VirtualMachineInstanceReasonHotplugGuestRejected, err)
 	}
+	vmiConditions.RemoveCondition(vmi, v1.VirtualMachineInstanceHotplugRolledBack)
 
 	if vmi.Status.CurrentCPUTopology == nil {
 		vmi.Status.CurrentCPUTopology = &v1.CPUTopology{}
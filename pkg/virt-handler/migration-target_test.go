@@ -550,6 +550,12 @@ var _ = Describe("VirtualMachineInstance migration target", func() {
 		Expect(updatedVMI.Status.Conditions).NotTo(ContainElement(MatchFields(IgnoreExtras, Fields{
 			"Type": Equal(v1.VirtualMachineInstanceVCPUChange),
 		})))
+		Expect(updatedVMI.Status.Conditions).To(ContainElement(MatchFields(IgnoreExtras, Fields{
+			"Type":    Equal(v1.VirtualMachineInstanceHotplugRolledBack),
+			"Status":  Equal(k8sv1.ConditionTrue),
+			"Reason":  Equal(v1.VirtualMachineInstanceReasonHotplugGuestRejected),
+			"Message": Equal("some error"),
+		})))
 	})
 
 	It("should hotplug CPU in post-migration when target pod has the required conditions", func() {
@@ -582,6 +588,10 @@ var _ = Describe("VirtualMachineInstance migration target", func() {
 		vmi.Status.Conditions = append(vmi.Status.Conditions, v1.VirtualMachineInstanceCondition{
 			Type:   v1.VirtualMachineInstanceVCPUChange,
 			Status: k8sv1.ConditionTrue,
+		}, v1.VirtualMachineInstanceCondition{
+			Type:   v1.VirtualMachineInstanceHotplugRolledBack,
+			Status: k8sv1.ConditionTrue,
+			Reason: v1.VirtualMachineInstanceReasonHotplugGuestRejected,
 		})
 
 		domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
@@ -607,6 +617,68 @@ var _ = Describe("VirtualMachineInstance migration target", func() {
 		Expect(updatedVMI.Status.Conditions).NotTo(ContainElement(MatchFields(IgnoreExtras, Fields{
 			"Type": Equal(v1.VirtualMachineInstanceVCPUChange),
 		})))
+		Expect(updatedVMI.Status.Conditions).NotTo(ContainElement(MatchFields(IgnoreExtras, Fields{
+			"Type": Equal(v1.VirtualMachineInstanceHotplugRolledBack),
+		})))
+	})
+
+	It("should require a migration when the hotplugged vCPUs exceed the CPU limit of the launcher", func() {
+		vmi := api2.NewMinimalVMI("testvmi")
+		vmi.UID = vmiTestUUID
+		vmi.ObjectMeta.ResourceVersion = "1"
+		vmi.Status.Phase = v1.Running
+		vmi.Labels = make(map[string]string)
+		vmi.Status.NodeName = host
+		vmi.Labels[v1.MigrationTargetNodeNameLabel] = host
+		vmi.Labels[v1.VirtualMachinePodCPULimitsLabel] = "2"
+		pastTime := metav1.NewTime(metav1.Now().Add(time.Duration(-10) * time.Second))
+		vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{
+			TargetNode:                     host,
+			TargetNodeAddress:              "127.0.0.1:12345",
+			SourceNode:                     "othernode",
+			MigrationUID:                   "123",
+			TargetNodeDomainDetected:       true,
+			TargetNodeDomainReadyTimestamp: pointer.P(metav1.Now()),
+			StartTimestamp:                 &pastTime,
+			EndTimestamp:                   pointer.P(metav1.Now()),
+		}
+		vmi.Spec.Domain.CPU = &v1.CPU{
+			Sockets:               4,
+			Cores:                 1,
+			Threads:               1,
+			DedicatedCPUPlacement: true,
+		}
+		vmi.Status.Conditions = append(vmi.Status.Conditions, v1.VirtualMachineInstanceCondition{
+			Type:   v1.VirtualMachineInstanceVCPUChange,
+			Status: k8sv1.ConditionTrue,
+		})
+
+		domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+		domain.Status.Status = api.Running
+		domain.Spec.Metadata.KubeVirt.Migration = &api.MigrationMetadata{
+			UID:            "123",
+			StartTimestamp: &pastTime,
+			EndTimestamp:   pointer.P(metav1.Now()),
+		}
+
+		addVMI(vmi, domain)
+
+		client.EXPECT().Ping().AnyTimes()
+		client.EXPECT().FinalizeVirtualMachineMigration(gomock.Any(), gomock.Any())
+
+		sanityExecute()
+
+		testutils.ExpectEvent(recorder, "failed to change vCPUs")
+		updatedVMI, err := virtfakeClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Get(context.TODO(), vmi.Name, metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(updatedVMI.Status.Conditions).NotTo(ContainElement(MatchFields(IgnoreExtras, Fields{
+			"Type": Equal(v1.VirtualMachineInstanceVCPUChange),
+		})))
+		Expect(updatedVMI.Status.Conditions).To(ContainElement(MatchFields(IgnoreExtras, Fields{
+			"Type":   Equal(v1.VirtualMachineInstanceHotplugRolledBack),
+			"Status": Equal(k8sv1.ConditionTrue),
+			"Reason": Equal(v1.VirtualMachineInstanceReasonHotplugMigrationRequired),
+		})))
 	})
 
 	It("migration should be marked as completed after finalization", func() {
@@ -35,6 +35,7 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/log"
 
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 	cmdclient "kubevirt.io/kubevirt/pkg/virt-handler/cmd-client"
 )
 
@@ -46,16 +47,18 @@ const (
 )
 
 type LifecycleHandler struct {
-	recorder     record.EventRecorder
-	vmiStore     cache.Store
-	virtShareDir string
+	recorder      record.EventRecorder
+	vmiStore      cache.Store
+	virtShareDir  string
+	clusterConfig *virtconfig.ClusterConfig
 }
 
-func NewLifecycleHandler(recorder record.EventRecorder, vmiStore cache.Store, virtShareDir string) *LifecycleHandler {
+func NewLifecycleHandler(recorder record.EventRecorder, vmiStore cache.Store, virtShareDir string, clusterConfig *virtconfig.ClusterConfig) *LifecycleHandler {
 	return &LifecycleHandler{
-		recorder:     recorder,
-		vmiStore:     vmiStore,
-		virtShareDir: virtShareDir,
+		recorder:      recorder,
+		vmiStore:      vmiStore,
+		virtShareDir:  virtShareDir,
+		clusterConfig: clusterConfig,
 	}
 }
 
@@ -0,0 +1,130 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+
+	"github.com/emicklei/go-restful/v3"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+const bytesPerMegabyte = 1024 * 1024
+
+func (lh *LifecycleHandler) MigrationFeasibilityHandler(request *restful.Request, response *restful.Response) {
+	vmi, client, err := lh.getVMILauncherClient(request, response)
+	if err != nil {
+		return
+	}
+	defer client.Close()
+
+	dirtyRateMbps, err := client.GetDomainDirtyRateStats()
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to get the dirty rate")
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+
+	dirtyRate := dirtyRateMbps
+	if dirtyRate > 0 {
+		dirtyRate *= bytesPerMegabyte
+	}
+	feasibility := estimateMigrationFeasibility(vmi, dirtyRate, lh.clusterConfig.GetMigrationConfiguration())
+	response.WriteEntity(feasibility)
+}
+
+// estimateMigrationFeasibility compares the dirty rate of the guest with the bandwidth
+// of a single migration. Pre-copy transfers the memory once and then keeps resending
+// what the guest dirtied meanwhile, so it only converges while the dirty rate stays
+// below the bandwidth and needs roughly memory / (bandwidth - dirty rate) to complete.
+// A negative dirty rate means it could not be measured.
+func estimateMigrationFeasibility(
+	vmi *v1.VirtualMachineInstance, dirtyRate int64, config *v1.MigrationConfiguration,
+) v1.VirtualMachineInstanceMigrationFeasibility {
+	memory := getVMIMigrationMemory(vmi)
+
+	completionTimeoutPerGiB := virtconfig.MigrationCompletionTimeoutPerGiB
+	if config.CompletionTimeoutPerGiB != nil {
+		completionTimeoutPerGiB = *config.CompletionTimeoutPerGiB
+	}
+
+	feasibility := v1.VirtualMachineInstanceMigrationFeasibility{
+		DirtyRateBytesPerSecond:  dirtyRate,
+		MemoryBytes:              memory.Value(),
+		CompletionTimeoutSeconds: completionTimeoutPerGiB * memory.ScaledValue(resource.Giga),
+	}
+	if config.BandwidthPerMigration != nil {
+		feasibility.BandwidthBytesPerSecond = config.BandwidthPerMigration.Value()
+	}
+
+	bandwidth := feasibility.BandwidthBytesPerSecond
+	switch {
+	case dirtyRate < 0:
+		feasibility.Reason = "the dirty rate of the guest is unknown"
+		return feasibility
+	case bandwidth <= 0:
+		feasibility.Feasible = true
+		feasibility.Reason = "the migration bandwidth is not limited"
+		return feasibility
+	case dirtyRate >= bandwidth:
+		feasibility.Reason = "the guest dirties its memory faster than the migration bandwidth allows to transfer it"
+	default:
+		estimatedDuration := int64(math.Ceil(float64(feasibility.MemoryBytes) / float64(bandwidth-dirtyRate)))
+		if estimatedDuration <= feasibility.CompletionTimeoutSeconds {
+			feasibility.EstimatedDurationSeconds = &estimatedDuration
+			feasibility.Feasible = true
+			feasibility.Reason = "the guest memory is expected to be transferred within the completion timeout"
+			return feasibility
+		}
+		feasibility.Reason = fmt.Sprintf("the estimated duration of %ds exceeds the completion timeout", estimatedDuration)
+	}
+
+	if config.AllowPostCopy != nil && *config.AllowPostCopy {
+		feasibility.Feasible = true
+		feasibility.Reason += ", the migration is expected to switch to post-copy"
+	} else if config.AllowAutoConverge != nil && *config.AllowAutoConverge {
+		feasibility.Feasible = true
+		feasibility.Reason += ", the guest vCPUs are expected to be throttled until the migration converges"
+	}
+
+	return feasibility
+}
+
+// getVMIMigrationMemory returns the guest memory virt-launcher accounts for when it
+// derives the completion timeout of a migration.
+func getVMIMigrationMemory(vmi *v1.VirtualMachineInstance) resource.Quantity {
+	var memory resource.Quantity
+	if v, ok := vmi.Spec.Domain.Resources.Requests[k8sv1.ResourceMemory]; ok {
+		memory = v
+	}
+	if vmi.Spec.Domain.Memory != nil && vmi.Spec.Domain.Memory.Guest != nil {
+		memory = *vmi.Spec.Domain.Memory.Guest
+	}
+	return memory
+}
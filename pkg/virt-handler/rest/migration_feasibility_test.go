@@ -0,0 +1,126 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+)
+
+var _ = Describe("Migration feasibility", func() {
+	const (
+		memoryBytes       = int64(1000 * 1000 * 1000)
+		completionTimeout = int64(100)
+	)
+
+	newVMI := func() *v1.VirtualMachineInstance {
+		return &v1.VirtualMachineInstance{
+			Spec: v1.VirtualMachineInstanceSpec{
+				Domain: v1.DomainSpec{
+					Resources: v1.ResourceRequirements{
+						Requests: k8sv1.ResourceList{
+							k8sv1.ResourceMemory: resource.MustParse("1G"),
+						},
+					},
+				},
+			},
+		}
+	}
+
+	newConfig := func(bandwidth string) *v1.MigrationConfiguration {
+		config := &v1.MigrationConfiguration{
+			CompletionTimeoutPerGiB: pointer.P(completionTimeout),
+		}
+		if bandwidth != "" {
+			config.BandwidthPerMigration = pointer.P(resource.MustParse(bandwidth))
+		}
+		return config
+	}
+
+	withPostCopy := func(config *v1.MigrationConfiguration) *v1.MigrationConfiguration {
+		config.AllowPostCopy = pointer.P(true)
+		return config
+	}
+
+	withAutoConverge := func(config *v1.MigrationConfiguration) *v1.MigrationConfiguration {
+		config.AllowAutoConverge = pointer.P(true)
+		return config
+	}
+
+	DescribeTable("should estimate whether the migration converges", func(dirtyRate int64, config *v1.MigrationConfiguration, expectedFeasible bool, expectedDuration *int64, expectedReason string) {
+		feasibility := estimateMigrationFeasibility(newVMI(), dirtyRate, config)
+
+		Expect(feasibility.DirtyRateBytesPerSecond).To(Equal(dirtyRate))
+		Expect(feasibility.MemoryBytes).To(Equal(memoryBytes))
+		Expect(feasibility.CompletionTimeoutSeconds).To(Equal(completionTimeout))
+		Expect(feasibility.Feasible).To(Equal(expectedFeasible))
+		Expect(feasibility.EstimatedDurationSeconds).To(Equal(expectedDuration))
+		Expect(feasibility.Reason).To(Equal(expectedReason))
+	},
+		Entry("feasible when the memory is transferred within the completion timeout",
+			int64(50*1000*1000), newConfig("100M"), true, pointer.P(int64(20)),
+			"the guest memory is expected to be transferred within the completion timeout"),
+		Entry("infeasible when the estimated duration exceeds the completion timeout",
+			int64(0), newConfig("1M"), false, nil,
+			"the estimated duration of 1000s exceeds the completion timeout"),
+		Entry("infeasible when the dirty rate reaches the bandwidth",
+			int64(100*1000*1000), newConfig("100M"), false, nil,
+			"the guest dirties its memory faster than the migration bandwidth allows to transfer it"),
+		Entry("feasible when the bandwidth is not limited",
+			int64(100*1000*1000), newConfig(""), true, nil,
+			"the migration bandwidth is not limited"),
+		Entry("unknown when the dirty rate could not be measured",
+			int64(-1), newConfig("100M"), false, nil,
+			"the dirty rate of the guest is unknown"),
+		Entry("feasible with post-copy when the migration does not converge",
+			int64(100*1000*1000), withPostCopy(newConfig("100M")), true, nil,
+			"the guest dirties its memory faster than the migration bandwidth allows to transfer it, "+
+				"the migration is expected to switch to post-copy"),
+		Entry("feasible with auto-converge when the migration does not converge",
+			int64(0), withAutoConverge(newConfig("1M")), true, nil,
+			"the estimated duration of 1000s exceeds the completion timeout, "+
+				"the guest vCPUs are expected to be throttled until the migration converges"),
+	)
+
+	It("should fall back to the default completion timeout", func() {
+		feasibility := estimateMigrationFeasibility(newVMI(), 0, &v1.MigrationConfiguration{})
+
+		Expect(feasibility.CompletionTimeoutSeconds).To(Equal(int64(150)))
+		Expect(feasibility.Feasible).To(BeTrue())
+	})
+
+	It("should use the guest memory over the memory request", func() {
+		vmi := newVMI()
+		vmi.Spec.Domain.Memory = &v1.Memory{Guest: pointer.P(resource.MustParse("2G"))}
+
+		feasibility := estimateMigrationFeasibility(vmi, 0, newConfig("100M"))
+
+		Expect(feasibility.MemoryBytes).To(Equal(2 * memoryBytes))
+		Expect(feasibility.CompletionTimeoutSeconds).To(Equal(2 * completionTimeout))
+		Expect(feasibility.EstimatedDurationSeconds).To(Equal(pointer.P(int64(20))))
+	})
+})
@@ -24,6 +24,7 @@ import (
 
 	v1 "kubevirt.io/api/core/v1"
 
+	"kubevirt.io/kubevirt/pkg/network/multiqueue"
 	netvmispec "kubevirt.io/kubevirt/pkg/network/vmispec"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/device"
@@ -212,7 +213,7 @@ func indexNetworksByName(networks []v1.Network) map[string]*v1.Network {
 
 func newVirtioDriver(vmi *v1.VirtualMachineInstance, requiresIOMMU bool) *api.InterfaceDriver {
 	var driver *api.InterfaceDriver
-	queueCount := uint(NetworkQueuesCapacity(vmi))
+	queueCount := uint(multiqueue.QueuesCapacity(vmi))
 
 	if queueCount > 0 || requiresIOMMU {
 		driver = &api.InterfaceDriver{Name: "vhost"}
@@ -1140,6 +1140,16 @@ var CRDsValidation map[string]string = map[string]string{
                     in case hardware-assisted emulation is not available. Defaults to false
                   type: boolean
               type: object
+            durationHistogramBuckets:
+              description: |-
+                DurationHistogramBuckets overrides the bucket upper bounds of the virt-controller and virt-api duration
+                histograms, such as the VirtualMachineInstance phase transition, migration, hotplug and API request durations.
+                The buckets are read when the components start, so changes only apply after they restart.
+                If not set, each histogram keeps its default buckets.
+              items:
+                type: string
+              type: array
+              x-kubernetes-list-type: atomic
             emulatedMachines:
               description: Deprecated. Use architectureConfiguration instead.
               items:
@@ -1309,6 +1319,15 @@ var CRDsValidation map[string]string = map[string]string{
             machineType:
               description: Deprecated. Use architectureConfiguration instead.
               type: string
+            maxEphemeralHotplugVolumes:
+              description: |-
+                MaxEphemeralHotplugVolumes limits the number of ephemeral hotplug volumes, volumes hotplugged
+                to a VirtualMachineInstance without being added to the VirtualMachine, that can be attached
+                at the same time. Volumes above the limit are held until other ones are removed.
+                The limit can be lowered per namespace with the kubevirt.io/max-ephemeral-hotplug-volumes annotation.
+                If not set, the number of ephemeral hotplug volumes is not limited.
+              format: int32
+              type: integer
             mediatedDevicesConfiguration:
               description: MediatedDevicesConfiguration holds information about MDEV
                 types to be defined, if available
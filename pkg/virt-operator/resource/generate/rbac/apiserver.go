@@ -254,6 +254,17 @@ func newApiServerClusterRole() *rbacv1.ClusterRole {
 					"watch",
 				},
 			},
+			{
+				APIGroups: []string{
+					"",
+				},
+				Resources: []string{
+					"events",
+				},
+				Verbs: []string{
+					"create", "patch",
+				},
+			},
 			{
 				APIGroups: []string{
 					"instancetype.kubevirt.io",
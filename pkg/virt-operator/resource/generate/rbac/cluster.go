@@ -91,6 +91,7 @@ const (
 	apiVMInstancesReset                     = "virtualmachineinstances/reset"
 	apiVMInstancesGuestOSInfo               = "virtualmachineinstances/guestosinfo"
 	apiVMInstancesFileSysList               = "virtualmachineinstances/filesystemlist"
+	apiVMInstancesMigrationFeasibility      = "virtualmachineinstances/migrationfeasibility"
 	apiVMInstancesUserList                  = "virtualmachineinstances/userlist"
 	apiVMInstancesSEVFetchCertChain         = "virtualmachineinstances/sev/fetchcertchain"
 	apiVMInstancesSEVQueryLaunchMeasurement = "virtualmachineinstances/sev/querylaunchmeasurement"
@@ -213,6 +214,7 @@ func newAdminClusterRole() *rbacv1.ClusterRole {
 					apiVMInstancesPortForward,
 					apiVMInstancesGuestOSInfo,
 					apiVMInstancesFileSysList,
+					apiVMInstancesMigrationFeasibility,
 					apiVMInstancesUserList,
 					apiVMInstancesSEVFetchCertChain,
 					apiVMInstancesSEVQueryLaunchMeasurement,
@@ -421,6 +423,7 @@ func newEditClusterRole() *rbacv1.ClusterRole {
 					apiVMInstancesPortForward,
 					apiVMInstancesGuestOSInfo,
 					apiVMInstancesFileSysList,
+					apiVMInstancesMigrationFeasibility,
 					apiVMInstancesUserList,
 					apiVMInstancesSEVFetchCertChain,
 					apiVMInstancesSEVQueryLaunchMeasurement,
@@ -640,6 +643,17 @@ func newMigrateClusterRole() *rbacv1.ClusterRole {
 					"update",
 				},
 			},
+			{
+				APIGroups: []string{
+					virtv1.SubresourceGroupName,
+				},
+				Resources: []string{
+					apiVMInstancesMigrationFeasibility,
+				},
+				Verbs: []string{
+					"get",
+				},
+			},
 			{
 				APIGroups: []string{
 					GroupName,
@@ -688,6 +702,7 @@ func newViewClusterRole() *rbacv1.ClusterRole {
 					apiVMExpandSpec,
 					apiVMInstancesGuestOSInfo,
 					apiVMInstancesFileSysList,
+					apiVMInstancesMigrationFeasibility,
 					apiVMInstancesUserList,
 					apiVMInstancesSEVFetchCertChain,
 					apiVMInstancesSEVQueryLaunchMeasurement,
@@ -115,6 +115,7 @@ func NewVirtctlCommandFn() *cobra.Command {
 		vm.NewRestartCommand(),
 		vm.NewMigrateCommand(),
 		vm.NewMigrateCancelCommand(),
+		vm.NewMigrateFeasibilityCommand(),
 		vm.NewGuestOsInfoCommand(),
 		vm.NewUserListCommand(),
 		vm.NewFSListCommand(),
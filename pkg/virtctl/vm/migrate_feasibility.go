@@ -0,0 +1,68 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/clientconfig"
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const COMMAND_MIGRATE_FEASIBILITY = "migrate-feasibility"
+
+func NewMigrateFeasibilityCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "migrate-feasibility (VMI)",
+		Short:   "Estimate whether a live migration of a virtual machine instance is expected to complete.",
+		Long:    "Compares the current memory dirty rate of the guest with the bandwidth and completion timeout of the cluster migration configuration.",
+		Example: usage(COMMAND_MIGRATE_FEASIBILITY),
+		Args:    cobra.ExactArgs(1),
+		RunE:    migrateFeasibilityRun,
+	}
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func migrateFeasibilityRun(cmd *cobra.Command, args []string) error {
+	vmiName := args[0]
+
+	virtClient, namespace, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	feasibility, err := virtClient.VirtualMachineInstance(namespace).MigrationFeasibility(context.Background(), vmiName)
+	if err != nil {
+		return fmt.Errorf("Error estimating the migration feasibility of VirtualMachineInstance %s, %v", vmiName, err)
+	}
+
+	data, err := json.MarshalIndent(feasibility, "", "  ")
+	if err != nil {
+		return fmt.Errorf("Cannot marshal migration feasibility %v", err)
+	}
+
+	cmd.Printf("%s\n", string(data))
+	return nil
+}
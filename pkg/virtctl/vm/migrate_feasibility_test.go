@@ -0,0 +1,83 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vm_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/testing"
+)
+
+var _ = Describe("Migrate feasibility command", func() {
+	var vmiInterface *kubecli.MockVirtualMachineInstanceInterface
+	const vmiName = "testvmi"
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		vmiInterface = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+	})
+
+	It("should fail with missing input parameters", func() {
+		cmd := testing.NewRepeatableVirtctlCommand("migrate-feasibility")
+		Expect(cmd()).To(MatchError("accepts 1 arg(s), received 0"))
+	})
+
+	It("should fail when the estimate cannot be retrieved", func() {
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance(k8smetav1.NamespaceDefault).Return(vmiInterface).Times(1)
+		vmiInterface.EXPECT().MigrationFeasibility(context.Background(), vmiName).
+			Return(v1.VirtualMachineInstanceMigrationFeasibility{}, fmt.Errorf("not running")).Times(1)
+
+		cmd := testing.NewRepeatableVirtctlCommand("migrate-feasibility", vmiName)
+		Expect(cmd()).To(MatchError("Error estimating the migration feasibility of VirtualMachineInstance testvmi, not running"))
+	})
+
+	It("should print the estimate", func() {
+		feasibility := v1.VirtualMachineInstanceMigrationFeasibility{
+			DirtyRateBytesPerSecond:  1024,
+			BandwidthBytesPerSecond:  64 * 1024 * 1024,
+			MemoryBytes:              1024 * 1024 * 1024,
+			CompletionTimeoutSeconds: 150,
+			Feasible:                 true,
+		}
+
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance(k8smetav1.NamespaceDefault).Return(vmiInterface).Times(1)
+		vmiInterface.EXPECT().MigrationFeasibility(context.Background(), vmiName).Return(feasibility, nil).Times(1)
+
+		out, err := testing.NewRepeatableVirtctlCommandWithOut("migrate-feasibility", vmiName)()
+		Expect(err).ToNot(HaveOccurred())
+
+		var printed v1.VirtualMachineInstanceMigrationFeasibility
+		Expect(json.Unmarshal(out, &printed)).To(Succeed())
+		Expect(printed).To(Equal(feasibility))
+	})
+})
@@ -2981,6 +2981,16 @@ func (in *KubeVirtConfiguration) DeepCopyInto(out *KubeVirtConfiguration) {
 		*out = new(RoleAggregationStrategy)
 		**out = **in
 	}
+	if in.MaxEphemeralHotplugVolumes != nil {
+		in, out := &in.MaxEphemeralHotplugVolumes, &out.MaxEphemeralHotplugVolumes
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.DurationHistogramBuckets != nil {
+		in, out := &in.DurationHistogramBuckets, &out.DurationHistogramBuckets
+		*out = make([]metav1.Duration, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -5808,6 +5818,36 @@ func (in *VirtualMachineInstanceMigrationCondition) DeepCopy() *VirtualMachineIn
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceMigrationFeasibility) DeepCopyInto(out *VirtualMachineInstanceMigrationFeasibility) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	if in.EstimatedDurationSeconds != nil {
+		in, out := &in.EstimatedDurationSeconds, &out.EstimatedDurationSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceMigrationFeasibility.
+func (in *VirtualMachineInstanceMigrationFeasibility) DeepCopy() *VirtualMachineInstanceMigrationFeasibility {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceMigrationFeasibility)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineInstanceMigrationFeasibility) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineInstanceMigrationList) DeepCopyInto(out *VirtualMachineInstanceMigrationList) {
 	*out = *in
@@ -6225,6 +6265,23 @@ func (in *VirtualMachineInstancePresetSpec) DeepCopy() *VirtualMachineInstancePr
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceProbeStateChange) DeepCopyInto(out *VirtualMachineInstanceProbeStateChange) {
+	*out = *in
+	in.TransitionTimestamp.DeepCopyInto(&out.TransitionTimestamp)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceProbeStateChange.
+func (in *VirtualMachineInstanceProbeStateChange) DeepCopy() *VirtualMachineInstanceProbeStateChange {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceProbeStateChange)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineInstanceProfile) DeepCopyInto(out *VirtualMachineInstanceProfile) {
 	*out = *in
@@ -6504,6 +6561,13 @@ func (in *VirtualMachineInstanceStatus) DeepCopyInto(out *VirtualMachineInstance
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ProbeHistory != nil {
+		in, out := &in.ProbeHistory, &out.ProbeHistory
+		*out = make([]VirtualMachineInstanceProbeStateChange, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Interfaces != nil {
 		in, out := &in.Interfaces, &out.Interfaces
 		*out = make([]VirtualMachineInstanceNetworkInterface, len(*in))
@@ -225,6 +225,17 @@ type VirtualMachineInstancePhaseTransitionTimestamp struct {
 	PhaseTransitionTimestamp metav1.Time `json:"phaseTransitionTimestamp,omitempty"`
 }
 
+// VirtualMachineInstanceProbeStateChange gives a timestamp in relation to when the readiness probe status of a vmi changed
+type VirtualMachineInstanceProbeStateChange struct {
+	// Status is the readiness probe status after the change, as reported by the virt-launcher pod Ready condition
+	Status k8sv1.ConditionStatus `json:"status"`
+	// Reason is the reason of the virt-launcher pod Ready condition after the change
+	// +optional
+	Reason string `json:"reason,omitempty"`
+	// TransitionTimestamp is the timestamp of when the probe status change occurred
+	TransitionTimestamp metav1.Time `json:"transitionTimestamp,omitempty"`
+}
+
 type TopologyHints struct {
 	TSCFrequency *int64 `json:"tscFrequency,omitempty"`
 }
@@ -245,6 +256,11 @@ type VirtualMachineInstanceStatus struct {
 	// +listType=atomic
 	// +optional
 	PhaseTransitionTimestamps []VirtualMachineInstancePhaseTransitionTimestamp `json:"phaseTransitionTimestamps,omitempty"`
+	// ProbeHistory lists the latest readiness probe status changes of a vmi with a readiness probe, the oldest first.
+	// Only the 10 most recent changes are kept.
+	// +listType=atomic
+	// +optional
+	ProbeHistory []VirtualMachineInstanceProbeStateChange `json:"probeHistory,omitempty"`
 	// Interfaces represent the details of available network interfaces.
 	Interfaces []VirtualMachineInstanceNetworkInterface `json:"interfaces,omitempty"`
 	// Guest OS Information
@@ -683,6 +699,9 @@ const (
 	// Indicates that the VMI is hot(un)plugging memory
 	VirtualMachineInstanceMemoryChange VirtualMachineInstanceConditionType = "HotMemoryChange"
 
+	// Indicates that the last Hot vCPU Plug/UnPlug of the VMI was rolled back, the reason is specified in the condition
+	VirtualMachineInstanceHotplugRolledBack VirtualMachineInstanceConditionType = "HotplugRolledBack"
+
 	// Indicates that the VMI has an updates in its volume set
 	VirtualMachineInstanceVolumesChange VirtualMachineInstanceConditionType = "VolumesChange"
 
@@ -700,6 +719,10 @@ const (
 
 	// VirtualMachineInstanceEvictionRequested indicates that an eviction has been requested for the VMI
 	VirtualMachineInstanceEvictionRequested VirtualMachineInstanceConditionType = "EvictionRequested"
+
+	// VirtualMachineInstanceSchedulingBlockedByQuota indicates that the virt-launcher pod creation was rejected
+	// by a ResourceQuota or a LimitRange in the VMI namespace
+	VirtualMachineInstanceSchedulingBlockedByQuota VirtualMachineInstanceConditionType = "SchedulingBlockedByQuota"
 )
 
 // These are valid reasons for VMI conditions.
@@ -747,6 +770,16 @@ const (
 
 	// Indicates that an eviction has been requested for the VMI
 	VirtualMachineInstanceReasonEvictionRequested = "EvictionRequested"
+
+	// Reason means that the virt-launcher pod would exceed a ResourceQuota of the namespace
+	VirtualMachineInstanceReasonResourceQuotaExceeded = "ResourceQuotaExceeded"
+	// Reason means that the virt-launcher pod resources violate a LimitRange of the namespace
+	VirtualMachineInstanceReasonLimitRangeViolated = "LimitRangeViolated"
+
+	// Reason means that the guest did not accept the hotplugged vCPUs
+	VirtualMachineInstanceReasonHotplugGuestRejected = "GuestRejected"
+	// Reason means that the hotplugged vCPUs do not fit the virt-launcher pod resources, a new migration is required
+	VirtualMachineInstanceReasonHotplugMigrationRequired = "MigrationRequired"
 )
 
 const (
@@ -1373,6 +1406,11 @@ const (
 	// Must be a float >= 1.
 	AutoMemoryLimitsRatioLabel string = "alpha.kubevirt.io/auto-memory-limits-ratio"
 
+	// MaxEphemeralHotplugVolumesAnnotation sets, for all VMIs in the annotated namespace, the maximum
+	// number of ephemeral hotplug volumes. It can only lower the cluster wide maximum.
+	// Must be a non-negative integer.
+	MaxEphemeralHotplugVolumesAnnotation string = "kubevirt.io/max-ephemeral-hotplug-volumes"
+
 	// MigrationInterfaceName is an arbitrary name used in virt-handler to connect it to a dedicated migration network
 	MigrationInterfaceName string = "migration0"
 
@@ -2878,6 +2916,34 @@ type VirtualMachineInstanceFileSystemDisk struct {
 	BusType string `json:"busType"`
 }
 
+// VirtualMachineInstanceMigrationFeasibility estimates whether a live migration of the
+// VirtualMachineInstance is expected to complete with the cluster migration configuration.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineInstanceMigrationFeasibility struct {
+	metav1.TypeMeta `json:",inline"`
+	// DirtyRateBytesPerSecond is the rate at which the guest currently dirties its memory.
+	DirtyRateBytesPerSecond int64 `json:"dirtyRateBytesPerSecond"`
+	// BandwidthBytesPerSecond is the network bandwidth a single migration is allowed to use.
+	// Zero means the bandwidth is not limited.
+	// +optional
+	BandwidthBytesPerSecond int64 `json:"bandwidthBytesPerSecond,omitempty"`
+	// MemoryBytes is the amount of guest memory that has to be transferred.
+	MemoryBytes int64 `json:"memoryBytes"`
+	// CompletionTimeoutSeconds is the time after which the migration is aborted.
+	CompletionTimeoutSeconds int64 `json:"completionTimeoutSeconds"`
+	// EstimatedDurationSeconds is the estimated time to transfer the guest memory while the
+	// guest keeps dirtying it. It is not set when the bandwidth is not limited or when the
+	// migration is not expected to converge.
+	// +optional
+	EstimatedDurationSeconds *int64 `json:"estimatedDurationSeconds,omitempty"`
+	// Feasible is true when the migration is expected to complete.
+	Feasible bool `json:"feasible"`
+	// Reason is a human readable explanation of the estimate.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+}
+
 // VirtualMachineInstanceFileSystem represents guest os disk
 type VirtualMachineInstanceFileSystem struct {
 	DiskName       string                                 `json:"diskName"`
@@ -3112,6 +3178,22 @@ type KubeVirtConfiguration struct {
 	// +optional
 	// +kubebuilder:validation:Enum=AggregateToDefault;Manual
 	RoleAggregationStrategy *RoleAggregationStrategy `json:"roleAggregationStrategy,omitempty"`
+
+	// MaxEphemeralHotplugVolumes limits the number of ephemeral hotplug volumes, volumes hotplugged
+	// to a VirtualMachineInstance without being added to the VirtualMachine, that can be attached
+	// at the same time. Volumes above the limit are held until other ones are removed.
+	// The limit can be lowered per namespace with the kubevirt.io/max-ephemeral-hotplug-volumes annotation.
+	// If not set, the number of ephemeral hotplug volumes is not limited.
+	// +optional
+	MaxEphemeralHotplugVolumes *uint32 `json:"maxEphemeralHotplugVolumes,omitempty"`
+
+	// DurationHistogramBuckets overrides the bucket upper bounds of the virt-controller and virt-api duration
+	// histograms, such as the VirtualMachineInstance phase transition, migration, hotplug and API request durations.
+	// The buckets are read when the components start, so changes only apply after they restart.
+	// If not set, each histogram keeps its default buckets.
+	// +listType=atomic
+	// +optional
+	DurationHistogramBuckets []metav1.Duration `json:"durationHistogramBuckets,omitempty"`
 }
 
 // QGSConfiguration holds QGS configuration
@@ -52,6 +52,15 @@ func (VirtualMachineInstancePhaseTransitionTimestamp) SwaggerDoc() map[string]st
 	}
 }
 
+func (VirtualMachineInstanceProbeStateChange) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                    "VirtualMachineInstanceProbeStateChange gives a timestamp in relation to when the readiness probe status of a vmi changed",
+		"status":              "Status is the readiness probe status after the change, as reported by the virt-launcher pod Ready condition",
+		"reason":              "Reason is the reason of the virt-launcher pod Ready condition after the change\n+optional",
+		"transitionTimestamp": "TransitionTimestamp is the timestamp of when the probe status change occurred",
+	}
+}
+
 func (TopologyHints) SwaggerDoc() map[string]string {
 	return map[string]string{}
 }
@@ -64,6 +73,7 @@ func (VirtualMachineInstanceStatus) SwaggerDoc() map[string]string {
 		"conditions":                    "Conditions are specific points in VirtualMachineInstance's pod runtime.",
 		"phase":                         "Phase is the status of the VirtualMachineInstance in kubernetes world. It is not the VirtualMachineInstance status, but partially correlates to it.",
 		"phaseTransitionTimestamps":     "PhaseTransitionTimestamp is the timestamp of when the last phase change occurred\n+listType=atomic\n+optional",
+		"probeHistory":                  "ProbeHistory lists the latest readiness probe status changes of a vmi with a readiness probe, the oldest first.\nOnly the 10 most recent changes are kept.\n+listType=atomic\n+optional",
 		"interfaces":                    "Interfaces represent the details of available network interfaces.",
 		"guestOSInfo":                   "Guest OS Information",
 		"migrationState":                "Represents the status of a live migration",
@@ -791,6 +801,19 @@ func (VirtualMachineInstanceFileSystemDisk) SwaggerDoc() map[string]string {
 	}
 }
 
+func (VirtualMachineInstanceMigrationFeasibility) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                         "VirtualMachineInstanceMigrationFeasibility estimates whether a live migration of the\nVirtualMachineInstance is expected to complete with the cluster migration configuration.\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"dirtyRateBytesPerSecond":  "DirtyRateBytesPerSecond is the rate at which the guest currently dirties its memory.",
+		"bandwidthBytesPerSecond":  "BandwidthBytesPerSecond is the network bandwidth a single migration is allowed to use.\nZero means the bandwidth is not limited.\n+optional",
+		"memoryBytes":              "MemoryBytes is the amount of guest memory that has to be transferred.",
+		"completionTimeoutSeconds": "CompletionTimeoutSeconds is the time after which the migration is aborted.",
+		"estimatedDurationSeconds": "EstimatedDurationSeconds is the estimated time to transfer the guest memory while the\nguest keeps dirtying it. It is not set when the bandwidth is not limited or when the\nmigration is not expected to converge.\n+optional",
+		"feasible":                 "Feasible is true when the migration is expected to complete.",
+		"reason":                   "Reason is a human readable explanation of the estimate.\n+optional",
+	}
+}
+
 func (VirtualMachineInstanceFileSystem) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"": "VirtualMachineInstanceFileSystem represents guest os disk",
@@ -890,6 +913,8 @@ func (KubeVirtConfiguration) SwaggerDoc() map[string]string {
 		"changedBlockTrackingLabelSelectors": "ChangedBlockTrackingLabelSelectors defines label selectors. VMs matching these selectors will have changed block tracking enabled.\nEnabling changedBlockTracking is mandatory for performing storage-agnostic backups and incremental backups.\n+nullable",
 		"confidentialCompute":                "QGS configuration for attestation on the Intel TDX Platform\n+nullable",
 		"roleAggregationStrategy":            "RoleAggregationStrategy controls whether RBAC cluster roles should be aggregated\nto the default Kubernetes roles (admin, edit, view).\nWhen set to \"AggregateToDefault\" (default) or not specified, the aggregate-to-* labels are added to the cluster roles.\nWhen set to \"Manual\", the labels are not added, and roles will not be aggregated to the default roles.\nSetting this field to \"Manual\" requires the OptOutRoleAggregation feature gate to be enabled.\nThis is an Alpha feature and subject to change.\n+optional\n+kubebuilder:validation:Enum=AggregateToDefault;Manual",
+		"maxEphemeralHotplugVolumes":         "MaxEphemeralHotplugVolumes limits the number of ephemeral hotplug volumes, volumes hotplugged\nto a VirtualMachineInstance without being added to the VirtualMachine, that can be attached\nat the same time. Volumes above the limit are held until other ones are removed.\nThe limit can be lowered per namespace with the kubevirt.io/max-ephemeral-hotplug-volumes annotation.\nIf not set, the number of ephemeral hotplug volumes is not limited.\n+optional",
+		"durationHistogramBuckets":           "DurationHistogramBuckets overrides the bucket upper bounds of the virt-controller and virt-api duration\nhistograms, such as the VirtualMachineInstance phase transition, migration, hotplug and API request durations.\nThe buckets are read when the components start, so changes only apply after they restart.\nIf not set, each histogram keeps its default buckets.\n+listType=atomic\n+optional",
 	}
 }
 
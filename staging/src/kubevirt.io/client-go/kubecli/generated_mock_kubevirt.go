@@ -1608,6 +1608,21 @@ func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) List(ctx, opts any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).List), ctx, opts)
 }
 
+// MigrationFeasibility mocks base method.
+func (m *MockVirtualMachineInstanceInterface) MigrationFeasibility(ctx context.Context, name string) (v122.VirtualMachineInstanceMigrationFeasibility, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MigrationFeasibility", ctx, name)
+	ret0, _ := ret[0].(v122.VirtualMachineInstanceMigrationFeasibility)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MigrationFeasibility indicates an expected call of MigrationFeasibility.
+func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) MigrationFeasibility(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrationFeasibility", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).MigrationFeasibility), ctx, name)
+}
+
 // ObjectGraph mocks base method.
 func (m *MockVirtualMachineInstanceInterface) ObjectGraph(ctx context.Context, name string, objectGraphOptions *v122.ObjectGraphOptions) (v122.ObjectGraphNode, error) {
 	m.ctrl.T.Helper()
@@ -37,6 +37,8 @@ const (
 	filesystemListTemplateURI     = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/filesystemlist"
 	screenshotTemplateURI         = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/vnc/screenshot"
 
+	migrationFeasibilityTemplateURI = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/migrationfeasibility"
+
 	sevFetchCertChainTemplateURI         = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/sev/fetchcertchain"
 	sevQueryLaunchMeasurementTemplateURI = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/sev/querylaunchmeasurement"
 	sevInjectLaunchSecretTemplateURI     = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/sev/injectlaunchsecret"
@@ -79,6 +81,7 @@ type VirtHandlerConn interface {
 	GuestInfoURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	UserListURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	FilesystemListURI(vmi *virtv1.VirtualMachineInstance) (string, error)
+	MigrationFeasibilityURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	BackupURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	RedefineCheckpointURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 }
@@ -331,6 +334,10 @@ func (v *virtHandlerConn) FilesystemListURI(vmi *virtv1.VirtualMachineInstance)
 	return v.formatURI(filesystemListTemplateURI, vmi)
 }
 
+func (v *virtHandlerConn) MigrationFeasibilityURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
+	return v.formatURI(migrationFeasibilityTemplateURI, vmi)
+}
+
 func (v *virtHandlerConn) SEVFetchCertChainURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
 	return v.formatURI(sevFetchCertChainTemplateURI, vmi)
 }
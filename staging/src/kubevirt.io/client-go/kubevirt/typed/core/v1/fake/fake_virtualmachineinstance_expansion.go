@@ -115,6 +115,16 @@ func (c *fakeVirtualMachineInstances) FilesystemList(ctx context.Context, name s
 	return v1.VirtualMachineInstanceFileSystemList{}, err
 }
 
+func (c *fakeVirtualMachineInstances) MigrationFeasibility(ctx context.Context, name string) (v1.VirtualMachineInstanceMigrationFeasibility, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetSubresourceAction(c.Resource(), c.Namespace(), "migrationfeasibility", name), &v1.VirtualMachineInstanceMigrationFeasibility{})
+
+	if obj == nil {
+		return v1.VirtualMachineInstanceMigrationFeasibility{}, err
+	}
+	return *obj.(*v1.VirtualMachineInstanceMigrationFeasibility), err
+}
+
 func (c *fakeVirtualMachineInstances) AddVolume(ctx context.Context, name string, addVolumeOptions *v1.AddVolumeOptions) error {
 	_, err := c.Fake.
 		Invokes(fake2.NewPutSubresourceAction(c.Resource(), c.Namespace(), "addvolume", name, addVolumeOptions), nil)
@@ -405,6 +405,10 @@ var _ = Describe("[rfe_id:500][crit:high][vendor:cnv-qe@redhat.com][level:compon
 				"virtualmachineinstances", "filesystemlist",
 				allowGetFor("admin", "edit", "view"),
 				denyAllFor("migrate", "default")),
+			Entry("on vmi migrationfeasibility",
+				"virtualmachineinstances", "migrationfeasibility",
+				allowGetFor("admin", "edit", "view", "migrate"),
+				denyAllFor("default")),
 			Entry("on vmi addvolume",
 				"virtualmachineinstances", "addvolume",
 				allowUpdateFor("admin", "edit"),
@@ -30,7 +30,7 @@ func RegisterAllMetrics() error {
 		return err
 	}
 
-	if err := virthandler.SetupMetrics("", 0, nil, nil); err != nil {
+	if err := virthandler.SetupMetrics("", 0, nil, nil, nil); err != nil {
 		return err
 	}
 
@@ -69,6 +69,11 @@ var _ = Describe("[sig-monitoring]Metrics", decorators.SigMonitoring, func() {
 
 			// needs a snapshot - ignoring since already tested in - VM Monitoring, VM snapshot metrics
 			"kubevirt_vmsnapshot_succeeded_timestamp_seconds": true,
+			"kubevirt_vmsnapshot_duration_seconds":            true,
+
+			// needs a failed snapshot or a completed restore
+			"kubevirt_vmsnapshot_failed_total":    true,
+			"kubevirt_vmrestore_duration_seconds": true,
 
 			// needs a machines variable - ignoring since already tested in - tests/infrastructure/prometheus
 			"kubevirt_node_deprecated_machine_types": true,
@@ -90,6 +95,8 @@ var _ = Describe("[sig-monitoring]Metrics", decorators.SigMonitoring, func() {
 			"kubevirt_vmi_migration_data_bytes_total":                            true,
 			"kubevirt_vmi_migration_start_time_seconds":                          true,
 			"kubevirt_vmi_migration_end_time_seconds":                            true,
+			"kubevirt_vmi_migration_duration_seconds":                            true,
+			"kubevirt_vmi_migration_policy":                                      true,
 
 			// This metric is using a dedicated collector and is being tested separately
 			"kubevirt_vmi_dirty_rate_bytes_per_second": true,
@@ -98,13 +105,87 @@ var _ = Describe("[sig-monitoring]Metrics", decorators.SigMonitoring, func() {
 			"kubevirt_vmi_phase_transition_time_from_deletion_seconds": true,
 
 			// This metric is being tested in storage hotplug
-			"kubevirt_vmi_contains_ephemeral_hotplug_volume": true,
+			"kubevirt_vmi_contains_ephemeral_hotplug_volume":      true,
+			"kubevirt_vmi_ephemeral_hotplug_volume_count":         true,
+			"kubevirt_vmi_ephemeral_hotplug_volume_created_total": true,
+			"kubevirt_vmi_ephemeral_hotplug_volume_size_bytes":    true,
 
 			// CPU load metrics need an updated libvirt version running on the nodes
 			// that exposes the CPU load information
 			"kubevirt_vmi_guest_load_1m":  true,
 			"kubevirt_vmi_guest_load_5m":  true,
 			"kubevirt_vmi_guest_load_15m": true,
+
+			// Reported only for VMIs with a memory dump
+			"kubevirt_vmi_memory_dump_duration_seconds": true,
+
+			// Reported only once a hotplug volume is attached or detached
+			"kubevirt_vmi_hotplug_volume_attach_duration_seconds": true,
+			"kubevirt_vmi_hotplug_volume_detach_duration_seconds": true,
+
+			// Reported only once a hotplug volume fails to attach or detach
+			"kubevirt_vmi_hotplug_volume_errors_total": true,
+
+			// Reported only once an ephemeral hotplug volume is held by the volume limit
+			"kubevirt_vmi_ephemeral_hotplug_volume_limit_reached_total": true,
+
+			// Reported only once a pod eviction request is denied without triggering an evacuation
+			"kubevirt_vmi_eviction_blocked_total": true,
+
+			// Reported only once a running VMI with a readiness probe stops being ready
+			"kubevirt_vmi_probe_failures_total": true,
+
+			// Reported only once a virt-launcher pod container is OOM killed
+			"kubevirt_vmi_oom_events_total": true,
+
+			// Reported only once a virt-launcher pod is rejected by a ResourceQuota or a LimitRange
+			"kubevirt_vmi_creation_blocked_total": true,
+
+			// Reported only once a network interface is hotplugged or hotunplugged
+			"kubevirt_vmi_interface_hotplug_total":            true,
+			"kubevirt_vmi_interface_hotplug_duration_seconds": true,
+
+			// Reported only once a CPU or memory hotplug completes
+			"kubevirt_vmi_cpu_hotplug_total":               true,
+			"kubevirt_vmi_cpu_hotplug_duration_seconds":    true,
+			"kubevirt_vmi_memory_hotplug_total":            true,
+			"kubevirt_vmi_memory_hotplug_duration_seconds": true,
+
+			// Reported only for VMIs with a memory limit
+			"kubevirt_vmi_memory_overcommit_factor": true,
+
+			// Reported only for VMIs with a vGPU-backed display
+			"kubevirt_vmi_gpu_display_enabled": true,
+
+			// Reported only for VMIs with persistent TPM or EFI state
+			"kubevirt_vmi_backend_storage": true,
+
+			// Reported only for launcher pods with a seccomp, apparmor or selinux profile
+			"kubevirt_vmi_security_profile": true,
+
+			// Reported only for VMIs with a watchdog device
+			"kubevirt_vmi_watchdog": true,
+
+			// Reported only for VMIs with a features section
+			"kubevirt_vmi_firmware_features": true,
+
+			// Reported only for nodes running VMIs with hugepages
+			"kubevirt_node_vmi_hugepages_bytes": true,
+
+			// Reported only for VMIs with GPUs
+			"kubevirt_vmi_gpu_count": true,
+
+			// Reported only for VMIs with explicitly configured sound, video or input devices
+			"kubevirt_vmi_desktop_devices": true,
+
+			// Reported only for VMIs with a realtime CPU configuration
+			"kubevirt_vmi_realtime": true,
+
+			// Reported only for VMIs with a custom hostname or subdomain
+			"kubevirt_vmi_custom_hostname": true,
+
+			// Reported only once a VM lifecycle operation is requested
+			"kubevirt_vm_operations_total": true,
 		}
 
 		BeforeAll(func() {
@@ -898,6 +898,7 @@ var _ = Describe(SIG("Hotplug", func() {
 				ephemeralCount++
 
 				libmonitoring.WaitForMetricValue(virtClient, "sum(kubevirt_vmi_contains_ephemeral_hotplug_volume)", ephemeralCount)
+				libmonitoring.WaitForMetricValue(virtClient, "sum(kubevirt_vmi_ephemeral_hotplug_volume_count)", ephemeralCount)
 
 				By("Removing ephemeral volume")
 				removeVolumeVMI(vm2.Name, vm2.Namespace, "ephemeral-volume2", false)
@@ -905,6 +906,10 @@ var _ = Describe(SIG("Hotplug", func() {
 
 				By("Expecting metric to have decremented")
 				libmonitoring.WaitForMetricValue(virtClient, "sum(kubevirt_vmi_contains_ephemeral_hotplug_volume)", ephemeralCount)
+				libmonitoring.WaitForMetricValue(virtClient, "sum(kubevirt_vmi_ephemeral_hotplug_volume_count)", ephemeralCount)
+
+				By("Expecting the created counter to keep the unplugged volume")
+				libmonitoring.WaitForMetricValue(virtClient, "sum(kubevirt_vmi_ephemeral_hotplug_volume_created_total)", ephemeralCount+1)
 
 				By("Checking Alert is fired")
 				libmonitoring.VerifyAlertExist(virtClient, "VirtualMachineInstanceHasEphemeralHotplugVolume")
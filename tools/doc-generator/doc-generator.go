@@ -1,10 +1,14 @@
 package main
 
 import (
+	"encoding/json"
+	"flag"
 	"fmt"
+	"os"
 
 	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
 
+	"kubevirt.io/kubevirt/pkg/monitoring/metrics/common/catalog"
 	"kubevirt.io/kubevirt/pkg/monitoring/rules"
 	"kubevirt.io/kubevirt/tests/libmonitoring"
 
@@ -14,11 +18,23 @@ import (
 const title = `KubeVirt metrics`
 
 func main() {
+	jsonOutput := flag.Bool("json", false, "print the machine-readable metrics catalog instead of the markdown docs")
+	flag.Parse()
+
 	if err := libmonitoring.RegisterAllMetrics(); err != nil {
 		panic(err)
 	}
 
 	metricsList := operatormetrics.ListMetrics()
+	if *jsonOutput {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(catalog.Build(metricsList)); err != nil {
+			panic(err)
+		}
+		return
+	}
+
 	rulesList := rules.ListRecordingRules()
 
 	docsString := docs.BuildMetricsDocs(title, metricsList, rulesList)